@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/bench"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/runner"
+	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// benchmark implements the bench command, which replays the operations of
+// test cases against a target as a load test, and prints latency and error
+// statistics for each route.
+func benchmark(args []string) {
+	flags := flag.NewFlagSet("bench", flag.ExitOnError)
+	testsRoot := flags.String("testsRoot", "", "Path to a directory tree with test cases")
+	testsRemote := flags.String("testsRemote", runner.DefaultTestsRemote, "URL of a ZIP file containing a directory tree with test cases")
+	outDir := flags.String("outDir", "/tmp", "A directory where zip files will be downloaded and unzipped.")
+	targetLoc := flags.String("target", "NO TARGET PROVIDED", "A URL of a target to benchmark, for example http://localhost:5051")
+	healthTimeout := flags.String("healthTimeout", "1m", "The time to wait for the target to report itself as healthy.")
+	slotTimeout := flags.String("slotTimeout", "10m", "The time to wait for the target to sync the highest awaitSlot of the benchmarked cases.")
+	requestTimeout := flags.String("requestTimeout", "1m", "The time each request may take.")
+	subset := flags.String("subset", "/", "The subset of paths to benchmark. Defaults to \"/\" (all paths).")
+	requests := flags.Int("requests", 0, "The number of times to execute each case. Defaults to 100 unless --duration is set.")
+	duration := flags.String("duration", "", "How long to keep executing cases for. For example, 30s, 5m")
+	concurrency := flags.Int("concurrency", 8, "The maximum number of requests in flight at once.")
+	rate := flags.Float64("rate", 0, "The number of requests to start per second, across all cases. Defaults to 0, meaning as many as --concurrency allows.")
+	flags.Parse(args)
+
+	healthTimeoutDur, err := time.ParseDuration(*healthTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	slotTimeoutDur, err := time.ParseDuration(*slotTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	requestTimeoutDur, err := time.ParseDuration(*requestTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	var durationDur time.Duration
+	if *duration != "" {
+		durationDur, err = time.ParseDuration(*duration)
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+	}
+	if *requests == 0 && durationDur == 0 {
+		*requests = 100
+	}
+	targetUrl, err := url.Parse(*targetLoc)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	healthCtx, cancelHealth := context.WithTimeout(context.Background(), healthTimeoutDur)
+	err = target.IsHealthy(oapi.WithClient(healthCtx, *targetUrl))
+	cancelHealth()
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	testCases, err := testcases.All(&testcases.TestsCasesOpts{
+		Target:      *targetLoc,
+		TestsRoot:   *testsRoot,
+		TestsRemote: *testsRemote,
+		OutDir:      *outDir,
+	})
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	selected := []*testcases.Case{}
+	highestSlot := 0
+	for _, testCase := range testCases {
+		if !strings.HasPrefix(testCase.Config.Route, *subset) {
+			continue
+		}
+		selected = append(selected, testCase)
+		if testCase.Config.AwaitSlot > highestSlot {
+			highestSlot = testCase.Config.AwaitSlot
+		}
+	}
+
+	// Every case is replayed from the start, so the target must have synced
+	// all of their slots first.
+	ctx := withSlotClock(oapi.WithClient(context.Background(), *targetUrl))
+	if highestSlot > 0 {
+		slotCtx, cancelSlot := context.WithTimeout(ctx, slotTimeoutDur)
+		err = target.HasSlot(slotCtx, highestSlot)
+		cancelSlot()
+		if err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	// Built-in variables are resolved once, so that every request of a case
+	// is the same.
+	for i, testCase := range selected {
+		resolved, err := testCase.Resolve(ctx)
+		if err != nil {
+			fmt.Printf("%s: %s\n", testCase.Name(), err)
+			os.Exit(1)
+		}
+		selected[i] = resolved
+	}
+
+	result := bench.Run(ctx, selected, bench.Opts{
+		Requests:       *requests,
+		Duration:       durationDur,
+		Concurrency:    *concurrency,
+		Rate:           *rate,
+		RequestTimeout: requestTimeoutDur,
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ROUTE\tREQUESTS\tERRORS\tREQ/S\tP50\tP90\tP99\tMAX\t\n")
+	for _, s := range result.Routes {
+		printRouteStats(w, fmt.Sprintf("%s %s", s.Method, s.Route), s)
+	}
+	printRouteStats(w, "TOTAL", result.Total)
+	w.Flush()
+	fmt.Printf("\n%d requests in %s\n", result.Total.Requests, result.Elapsed.Round(time.Millisecond))
+}
+
+func printRouteStats(w *tabwriter.Writer, name string, s bench.RouteStats) {
+	fmt.Fprintf(w, "%s\t%d\t%d (%.1f%%)\t%.1f\t%s\t%s\t%s\t%s\t\n",
+		name,
+		s.Requests,
+		s.Errors,
+		s.ErrorRate()*100,
+		s.Throughput,
+		s.P50.Round(time.Microsecond),
+		s.P90.Round(time.Microsecond),
+		s.P99.Round(time.Microsecond),
+		s.Max.Round(time.Microsecond),
+	)
+}
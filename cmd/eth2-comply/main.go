@@ -4,103 +4,178 @@ import (
 	"context"
 	"flag"
 	"fmt"
-	"net/url"
+	"io"
 	"os"
 	"sort"
+	"strings"
 	"time"
 
-	"github.com/INFURA/eth2-comply/pkg/oapi"
-	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/report"
+	"github.com/INFURA/eth2-comply/pkg/runner"
 	"github.com/INFURA/eth2-comply/pkg/testcases"
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "record":
+			record(os.Args[2:])
+			return
+		case "bench":
+			benchmark(os.Args[2:])
+			return
+		case "serve-mock":
+			serveMock(os.Args[2:])
+			return
+		case "mutate":
+			mutate(os.Args[2:])
+			return
+		}
+	}
+
 	// Setup and parse CLI arguments.
 	testsRoot := flag.String("testsRoot", "", "Path to a directory tree with test cases")
-	testsRemote := flag.String("testsRemote", "https://github.com/INFURA/eth2-comply/releases/download/v0.3.1/tests-v0.3.1.zip", "URL of a ZIP file containing a directory tree with test cases")
+	testsRemote := flag.String("testsRemote", runner.DefaultTestsRemote, "URL of a ZIP file containing a directory tree with test cases")
 	outDir := flag.String("outDir", "/tmp", "A directory where zip files will be downloaded and unzipped.")
-	targetLoc := flag.String("target", "NO TARGET PROVIDED", "A URL to run tests against, for example http://localhost:5051")
-	timeout := flag.String("timeout", "10s", "The time to wait for a case execution to complete. For example, 3600s, 60m, 1h")
+	var targetLocs targetsFlag
+	flag.Var(&targetLocs, "target", "A URL to run tests against, for example http://localhost:5051. Repeat to also run every test against further targets, and compare their responses to those of the first.")
+	timeout := flag.String("timeout", "10s", "The time to wait for all case executions to complete. For example, 3600s, 60m, 1h")
+	healthTimeout := flag.String("healthTimeout", "1m", "The time to wait for the target to report itself as healthy, before any case is executed.")
+	slotTimeout := flag.String("slotTimeout", "0s", "The time each case may wait for the target to sync its awaitSlot and satisfy its await conditions. Defaults to 0s, meaning the wait is only bounded by --timeout.")
+	requestTimeout := flag.String("requestTimeout", "1m", "The time each case may take to execute its request, unless the case specifies its own timeout.")
 	subset := flag.String("subset", "/", "The subset of paths to run tests for. For example, set this to \"/v1/node\" to only run tests for routes in that path. Defaults to \"/\" (all paths).")
 	failSilent := flag.Bool("failSilent", false, "When true, return a 0 code even when tests fail. Defaults to false.")
+	concurrency := flag.Int("concurrency", 8, "The maximum number of requests in flight at once. Cases waiting for their awaitSlot or await conditions do not count towards it. Set to 0 to not limit requests.")
+	reportJUnit := flag.String("report-junit", "", "A file path to write a JUnit XML report of the test results to.")
+	reportJSON := flag.String("report-json", "", "A file path to write a JSON report of the test results to.")
+	strict := flag.Bool("strict", false, "When true, validate every response against the spec schema, failing on unknown, missing and malformed fields. Defaults to false.")
 	flag.Parse()
+	if len(targetLocs) == 0 {
+		targetLocs = targetsFlag{"NO TARGET PROVIDED"}
+	}
 
-	// Create test context with timeout.
+	// Parse the time budgets.
 	timeoutDur, err := time.ParseDuration(*timeout)
 	if err != nil {
 		fmt.Printf("%s\n", err)
 		os.Exit(1)
 	}
-	ctx, cancelFunc := context.WithTimeout(context.Background(), timeoutDur)
-
-	// Setup OAPI client.
-	targetUrl, err := url.Parse(*targetLoc)
+	healthTimeoutDur, err := time.ParseDuration(*healthTimeout)
 	if err != nil {
 		fmt.Printf("%s\n", err)
 		os.Exit(1)
 	}
-	ctx = oapi.WithClient(ctx, *targetUrl)
-
-	// Wait for target to become healthy
-	if err := target.IsHealthy(ctx); err != nil {
+	slotTimeoutDur, err := time.ParseDuration(*slotTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	requestTimeoutDur, err := time.ParseDuration(*requestTimeout)
+	if err != nil {
 		fmt.Printf("%s\n", err)
 		os.Exit(1)
 	}
 
 	// Get test cases.
-	opts := &testcases.TestsCasesOpts{
-		Target:      *targetLoc,
-		TestsRoot:   *testsRoot,
-		TestsRemote: *testsRemote,
-		OutDir:      *outDir,
-	}
-	testCases, err := testcases.All(opts)
+	r, err := runner.New(runner.Opts{
+		Targets:        targetLocs,
+		TestsRoot:      *testsRoot,
+		TestsRemote:    *testsRemote,
+		OutDir:         *outDir,
+		Subset:         *subset,
+		Concurrency:    *concurrency,
+		Strict:         *strict,
+		Timeout:        timeoutDur,
+		HealthTimeout:  healthTimeoutDur,
+		SlotTimeout:    slotTimeoutDur,
+		RequestTimeout: requestTimeoutDur,
+		Warn:           warn,
+	})
 	if err != nil {
 		fmt.Printf("%s\n", err)
 		os.Exit(1)
 	}
 
-	// Execute test cases.
-	for _, testCase := range testCases {
-		go testCase.Exec(ctx, *subset)
+	// Wait for targets to become healthy, and execute test cases.
+	if err := r.Start(context.Background()); err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
 	}
 
-	// Concurrent helper function cancels contexts (tests) that timeout.
-	go func(ctx context.Context, cancelFunc func()) {
-		deadline, ok := ctx.Deadline()
-		if !ok {
-			fmt.Printf("Could not get deadline for background context. Is timeout parameter specified properly?\n")
-			os.Exit(1)
-		}
-		for {
-			if time.Now().After(deadline) {
-				cancelFunc()
-				return
-			}
-			time.Sleep(time.Second)
-		}
-	}(ctx, cancelFunc)
-
 	// Sort testCases by awaitSlot. This enables us to always print the latest
 	// available test result.
+	testCases := make([]*testcases.Case, len(r.Cases()))
+	copy(testCases, r.Cases())
 	sort.Slice(testCases, func(i, j int) bool {
 		return testCases[i].Config.AwaitSlot < testCases[j].Config.AwaitSlot
 	})
 
-	// Print test results as they come in, and record whether any test failed.
-	hasFailures := false
+	// Print test results as they come in.
 	for _, testCase := range testCases {
 		<-testCase.Done
 
 		fmt.Printf("%s\n", testCase.ResultsPretty())
+	}
+	results := r.Wait()
 
-		if !testCase.Result.Success {
-			hasFailures = true
+	// Write reports.
+	if *reportJSON != "" {
+		if err := writeReport(*reportJSON, func(w io.Writer) error {
+			return report.WriteJSON(w, results.Run, results.Cases)
+		}); err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
+		}
+	}
+	if *reportJUnit != "" {
+		if err := writeReport(*reportJUnit, func(w io.Writer) error {
+			return report.WriteJUnit(w, results.Cases)
+		}); err != nil {
+			fmt.Printf("%s\n", err)
+			os.Exit(1)
 		}
 	}
 
 	// If any test was unsuccessful, exit with code 1.
-	if hasFailures && !*failSilent {
+	if !results.Success() && !*failSilent {
 		os.Exit(1)
 	}
 }
+
+// targetsFlag is a flag which may be given any number of times.
+type targetsFlag []string
+
+func (f *targetsFlag) String() string {
+	return strings.Join(*f, ", ")
+}
+
+func (f *targetsFlag) Set(value string) error {
+	*f = append(*f, value)
+	return nil
+}
+
+// writeReport creates the file at path and writes a report to it.
+func writeReport(path string, write func(w io.Writer) error) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// withSlotClock returns a context carrying the slot clock of the target in
+// ctx, so that waits for slots are scheduled by it. See runner.WithSlotClock.
+func withSlotClock(ctx context.Context) context.Context {
+	return runner.WithSlotClock(ctx, warn)
+}
+
+// warn prints a problem which does not stop the command.
+func warn(err error) {
+	fmt.Printf("Warning: %s\n", err)
+}
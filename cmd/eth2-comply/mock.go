@@ -0,0 +1,48 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+)
+
+// serveMock implements the serve-mock command, which serves the beacon node
+// API from fixtures and a generated chain, so that test suites can be run
+// without a live client.
+func serveMock(args []string) {
+	flags := flag.NewFlagSet("serve-mock", flag.ExitOnError)
+	listen := flags.String("listen", "localhost:5051", "The address to serve the API on.")
+	fixtures := flags.String("fixtures", "", "A directory of response bodies to serve in place of generated responses, at <method>/<path>.json, for example get/eth/v1/beacon/genesis.json.")
+	headSlot := flags.Int("headSlot", 0, "The slot of the generated chain's head when the mock starts. The head then advances with the wall clock.")
+	secondsPerSlot := flags.Int("secondsPerSlot", 12, "The duration of a slot of the generated chain, in seconds.")
+	slotsPerEpoch := flags.Int("slotsPerEpoch", 32, "The number of slots per epoch of the generated chain.")
+	validators := flags.Int("validators", 64, "The number of validators of the generated chain.")
+	flags.Parse(args)
+
+	if *headSlot < 0 || *secondsPerSlot <= 0 || *slotsPerEpoch <= 0 || *validators <= 0 {
+		fmt.Printf("--headSlot must not be negative, and --secondsPerSlot, --slotsPerEpoch and --validators must be positive\n")
+		os.Exit(1)
+	}
+
+	node, err := mock.New(mock.Opts{
+		Fixtures:       *fixtures,
+		Genesis:        time.Now().Add(-time.Duration(*headSlot**secondsPerSlot) * time.Second),
+		SecondsPerSlot: *secondsPerSlot,
+		SlotsPerEpoch:  *slotsPerEpoch,
+		Validators:     *validators,
+	})
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Serving a mock beacon node on http://%s\n", *listen)
+	if err := http.ListenAndServe(*listen, node); err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+}
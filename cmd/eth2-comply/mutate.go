@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/mutation"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/runner"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// mutate implements the mutate command, which runs test cases against a mock
+// beacon node corrupting its responses one way at a time, and reports the
+// corruptions no case caught for each route.
+func mutate(args []string) {
+	flags := flag.NewFlagSet("mutate", flag.ExitOnError)
+	testsRoot := flags.String("testsRoot", "", "Path to a directory tree with test cases")
+	testsRemote := flags.String("testsRemote", runner.DefaultTestsRemote, "URL of a ZIP file containing a directory tree with test cases")
+	outDir := flags.String("outDir", "/tmp", "A directory where zip files will be downloaded and unzipped.")
+	subset := flags.String("subset", "/", "The subset of paths to run tests for. Defaults to \"/\" (all paths).")
+	timeout := flags.String("timeout", "30m", "The time to wait for all mutations to be tried. For example, 3600s, 60m, 1h")
+	requestTimeout := flags.String("requestTimeout", "10s", "The time each case may take to execute its request, unless the case specifies its own timeout.")
+	concurrency := flags.Int("concurrency", 8, "The maximum number of requests in flight at once.")
+	strict := flags.Bool("strict", false, "When true, validate every response against the spec schema, failing on unknown, missing and malformed fields. Defaults to false.")
+	fixtures := flags.String("fixtures", "", "A directory of response bodies for the mock to serve in place of generated responses. See serve-mock.")
+	slotsPerEpoch := flags.Int("slotsPerEpoch", 32, "The number of slots per epoch of the mock's generated chain.")
+	validators := flags.Int("validators", 64, "The number of validators of the mock's generated chain.")
+	minScore := flags.Float64("minScore", 0, "The lowest fraction of mutations the cases must catch, for example 0.8. The exit code is non-zero if they catch fewer.")
+	flags.Parse(args)
+
+	timeoutDur, err := time.ParseDuration(*timeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	requestTimeoutDur, err := time.ParseDuration(*requestTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	testCases, err := testcases.All(&testcases.TestsCasesOpts{
+		TestsRoot:   *testsRoot,
+		TestsRemote: *testsRemote,
+		OutDir:      *outDir,
+		Strict:      *strict,
+	})
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	selected := []*testcases.Case{}
+	headSlot := 0
+	for _, testCase := range testCases {
+		if !testCase.InPaths(*subset) {
+			continue
+		}
+		selected = append(selected, testCase)
+		if slot := slotUsed(testCase.Config, *slotsPerEpoch); slot > headSlot {
+			headSlot = slot
+		}
+	}
+
+	// The chain's head starts past every slot and epoch the cases use, and
+	// advances slowly enough that responses hardly change while mutations
+	// are tried.
+	secondsPerSlot := 12
+	node, err := mock.New(mock.Opts{
+		Fixtures:       *fixtures,
+		Genesis:        time.Now().Add(-time.Duration(headSlot*secondsPerSlot) * time.Second),
+		SecondsPerSlot: secondsPerSlot,
+		SlotsPerEpoch:  *slotsPerEpoch,
+		Validators:     *validators,
+	})
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	go http.Serve(listener, node)
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), timeoutDur)
+	defer cancelFunc()
+	ctx = oapi.WithClient(ctx, url.URL{Scheme: "http", Host: listener.Addr().String()})
+
+	report := mutation.Run(ctx, node, selected, mutation.Opts{
+		Concurrency:    *concurrency,
+		RequestTimeout: requestTimeoutDur,
+	})
+
+	for _, broken := range report.Broken {
+		fmt.Printf("Not used, as it fails against the uncorrupted mock: %s\n", broken.ResultsPretty())
+	}
+	for _, route := range report.Routes {
+		fmt.Printf("%s %s: %d of %d mutations caught by %d cases\n", route.Method, route.Route, route.Killed(), route.Mutations, route.Cases)
+		for _, survived := range route.Survived {
+			fmt.Printf("  survived: %s\n", survived)
+		}
+	}
+	fmt.Printf("\nMutation score: %.1f%% (%d of %d mutations caught)\n", report.Score()*100, report.Killed, report.Mutations)
+	if ctx.Err() != nil {
+		fmt.Printf("Ran out of time before every mutation was tried.\n")
+		os.Exit(1)
+	}
+
+	if report.Score() < *minScore {
+		os.Exit(1)
+	}
+}
+
+// slotUsed returns the highest slot a case, or any of its steps, needs the
+// chain to have reached: its await slot, or the first slot of the highest
+// epoch in its routes.
+func slotUsed(config testcases.CaseConfig, slotsPerEpoch int) int {
+	configs := []testcases.CaseConfig{config}
+	for _, step := range config.Steps {
+		configs = append(configs, step.CaseConfig)
+	}
+
+	highest := 0
+	for _, config := range configs {
+		if config.AwaitSlot > highest {
+			highest = config.AwaitSlot
+		}
+		route, err := url.Parse(config.Route)
+		if err != nil {
+			continue
+		}
+		_, params, ok := apispec.MatchRoute(config.Method, route.Path)
+		if !ok {
+			continue
+		}
+		if epoch, err := strconv.Atoi(params["epoch"]); err == nil && epoch*slotsPerEpoch > highest {
+			highest = epoch * slotsPerEpoch
+		}
+	}
+	return highest
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// record implements the record command, which executes every case beneath a
+// tests root against a reference target, and writes the responses back into
+// the case files as their expectations.
+func record(args []string) {
+	flags := flag.NewFlagSet("record", flag.ExitOnError)
+	testsRoot := flags.String("testsRoot", "", "Path to a directory tree with test cases to record")
+	goldenDir := flags.String("goldenDir", "", "A directory to write recorded test cases to, at the same paths relative to --testsRoot. Defaults to overwriting the test cases in place.")
+	targetLoc := flags.String("target", "NO TARGET PROVIDED", "A URL of a reference target to record responses from, for example http://localhost:5051")
+	timeout := flags.String("timeout", "10m", "The time to wait for all cases to be recorded. For example, 3600s, 60m, 1h")
+	healthTimeout := flags.String("healthTimeout", "1m", "The time to wait for the target to report itself as healthy.")
+	subset := flags.String("subset", "/", "The subset of paths to record tests for. Defaults to \"/\" (all paths).")
+	flags.Parse(args)
+
+	if *testsRoot == "" {
+		fmt.Printf("record requires --testsRoot\n")
+		os.Exit(1)
+	}
+
+	timeoutDur, err := time.ParseDuration(*timeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	healthTimeoutDur, err := time.ParseDuration(*healthTimeout)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+	targetUrl, err := url.Parse(*targetLoc)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	healthCtx, cancelHealth := context.WithTimeout(context.Background(), healthTimeoutDur)
+	err = target.IsHealthy(oapi.WithClient(healthCtx, *targetUrl))
+	cancelHealth()
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), timeoutDur)
+	defer cancelFunc()
+	ctx = oapi.WithClient(ctx, *targetUrl)
+	ctx = withSlotClock(ctx)
+
+	testCases, err := testcases.All(&testcases.TestsCasesOpts{
+		Target:    *targetLoc,
+		TestsRoot: *testsRoot,
+	})
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	// Record in order of await slots, so that each wait is as short as
+	// possible.
+	sort.SliceStable(testCases, func(i, j int) bool {
+		return testCases[i].Config.AwaitSlot < testCases[j].Config.AwaitSlot
+	})
+
+	hasFailures := false
+	for _, testCase := range testCases {
+		if !testCase.InPaths(*subset) {
+			continue
+		}
+
+		path, err := testCase.Record(ctx, *testsRoot, *goldenDir)
+		if err != nil {
+			fmt.Printf("%s ❌\n%s: %s\n", testCase.Name(), testCase.Config.Source, err)
+			hasFailures = true
+			continue
+		}
+		fmt.Printf("%s ✅\n%s\n", testCase.Name(), path)
+	}
+
+	if hasFailures {
+		os.Exit(1)
+	}
+}
@@ -6,4 +6,5 @@ require (
 	github.com/antihax/optional v1.0.0
 	github.com/avast/retry-go v2.6.0+incompatible
 	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
+	gopkg.in/yaml.v2 v2.2.8
 )
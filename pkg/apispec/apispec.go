@@ -0,0 +1,105 @@
+// package apispec exposes the bundled Ethereum 2.0 API specification
+// (pkg/eth2spec/api/openapi.yaml) to the rest of eth2-comply. It is the source
+// of truth for which operations exist, which routes they are served on, and
+// what their responses must look like.
+package apispec
+
+//go:generate go run gen.go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// pathErrata maps paths which are misspelled in the bundled spec to the paths
+// beacon nodes actually serve.
+var pathErrata = map[string]string{
+	"/eth/v1/beacon/pool/atttester_slashings": "/eth/v1/beacon/pool/attester_slashings",
+}
+
+// methods are the keys of an OAPI path item which describe operations.
+var methods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// document is the parsed spec, using the same generic types encoding/json
+// produces (map[string]interface{}, []interface{}, string, etc.).
+var document map[string]interface{}
+
+// routes are all operations declared in the spec.
+var routes []Route
+
+func init() {
+	var err error
+	document, err = parseDocument(openapiYAML)
+	if err != nil {
+		panic(fmt.Sprintf("apispec: cannot parse bundled spec: %s", err))
+	}
+	routes = buildRoutes(document)
+}
+
+// parseDocument parses a YAML OAPI document into generic JSON-like types.
+func parseDocument(data string) (map[string]interface{}, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal([]byte(data), &raw); err != nil {
+		return nil, err
+	}
+
+	doc, ok := normalize(raw).(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("document root is not an object")
+	}
+
+	return doc, nil
+}
+
+// normalize converts the map[interface{}]interface{} values produced by the
+// YAML decoder into map[string]interface{}, recursively.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[fmt.Sprint(key)] = normalize(value)
+		}
+		return m
+	case []interface{}:
+		for i, value := range v {
+			v[i] = normalize(value)
+		}
+		return v
+	}
+	return v
+}
+
+// buildRoutes collects a Route for every operation in the spec's paths object.
+func buildRoutes(doc map[string]interface{}) []Route {
+	paths, _ := doc["paths"].(map[string]interface{})
+
+	pathKeys := make([]string, 0, len(paths))
+	for path := range paths {
+		pathKeys = append(pathKeys, path)
+	}
+	sort.Strings(pathKeys)
+
+	result := []Route{}
+	for _, path := range pathKeys {
+		item, _ := paths[path].(map[string]interface{})
+		servedPath := path
+		if corrected, ok := pathErrata[path]; ok {
+			servedPath = corrected
+		}
+
+		for _, method := range methods {
+			operation, ok := item[method].(map[string]interface{})
+			if !ok {
+				continue
+			}
+			operationId, _ := operation["operationId"].(string)
+			result = append(result, newRoute(strings.ToUpper(method), servedPath, operationId, operation))
+		}
+	}
+
+	return result
+}
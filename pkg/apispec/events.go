@@ -0,0 +1,61 @@
+package apispec
+
+// Topics are the event topics a client may subscribe to on the eventstream
+// operation, in the order the spec lists them.
+var Topics = []string{"head", "block", "attestation", "voluntary_exit", "finalized_checkpoint", "chain_reorg"}
+
+// eventSchemas describe the data of each event topic. The spec only gives
+// examples for the text/event-stream response of the eventstream operation,
+// so the schemas are written here after those examples, reusing the spec's
+// component schemas wherever an event carries one.
+var eventSchemas = map[string]Schema{
+	"head": {
+		"type": "object",
+		"properties": map[string]interface{}{
+			"slot":             component("Uint64"),
+			"block":            component("Root"),
+			"state":            component("Root"),
+			"epoch_transition": map[string]interface{}{"type": "boolean"},
+		},
+	},
+	"block": {
+		"type": "object",
+		"properties": map[string]interface{}{
+			"slot":  component("Uint64"),
+			"block": component("Root"),
+		},
+	},
+	"attestation":    component("Attestation"),
+	"voluntary_exit": component("SignedVoluntaryExit"),
+	"finalized_checkpoint": {
+		"type": "object",
+		"properties": map[string]interface{}{
+			"block": component("Root"),
+			"state": component("Root"),
+			"epoch": component("Uint64"),
+		},
+	},
+	"chain_reorg": {
+		"type": "object",
+		"properties": map[string]interface{}{
+			"slot":           component("Uint64"),
+			"depth":          component("Uint64"),
+			"old_head_block": component("Root"),
+			"new_head_block": component("Root"),
+			"old_head_state": component("Root"),
+			"new_head_state": component("Root"),
+			"epoch":          component("Uint64"),
+		},
+	},
+}
+
+// EventSchema returns the schema of the data of events with the given topic.
+func EventSchema(topic string) (Schema, bool) {
+	schema, ok := eventSchemas[topic]
+	return schema, ok
+}
+
+// component returns a schema referencing a component schema of the spec.
+func component(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
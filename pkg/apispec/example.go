@@ -0,0 +1,189 @@
+package apispec
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Example returns a value satisfying the schema, in the generic types
+// encoding/json produces, built from the examples the spec gives. Objects have
+// every declared property, and arrays have one item, or their minimum number
+// of items if that is more. The value is a new copy on each call, so it may be
+// modified freely.
+func (s Schema) Example() interface{} {
+	return example(s, 0)
+}
+
+// maxExampleDepth bounds the nesting of example values, in case a schema
+// refers to itself.
+const maxExampleDepth = 32
+
+func example(s Schema, depth int) interface{} {
+	s = flatten(s)
+	if depth > maxExampleDepth {
+		return nil
+	}
+
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if options := subschemas(s[key]); len(options) > 0 {
+			return example(options[0], depth+1)
+		}
+	}
+
+	_, hasProperties := s["properties"]
+	_, hasItems := s["items"]
+	switch {
+	case s["type"] == "object" || s["type"] == nil && hasProperties:
+		object := map[string]interface{}{}
+		properties, _ := s["properties"].(map[string]interface{})
+		for name, property := range properties {
+			if property, ok := property.(map[string]interface{}); ok {
+				object[name] = example(property, depth+1)
+			}
+		}
+		return object
+	case s["type"] == "array" || s["type"] == nil && hasItems:
+		items, _ := s["items"].(map[string]interface{})
+		count := 1
+		if minItems, ok := number(s["minItems"]); ok && int(minItems) > count {
+			count = int(minItems)
+		}
+		list := make([]interface{}, count)
+		for i := range list {
+			list[i] = example(items, depth+1)
+		}
+		return list
+	}
+
+	// The YAML decoder reads unquoted examples such as 0x01 as numbers, so
+	// examples are only used if they have the schema's type.
+	schemaType, _ := s["type"].(string)
+	if value, ok := s["example"]; ok && hasType(copyValue(value), schemaType) {
+		return copyValue(value)
+	}
+	if enum, ok := s["enum"].([]interface{}); ok && len(enum) > 0 {
+		return copyValue(enum[0])
+	}
+
+	switch schemaType {
+	case "string":
+		if pattern, ok := s["pattern"].(string); ok {
+			return patternExample(pattern)
+		}
+		return ""
+	case "integer", "number":
+		return float64(0)
+	case "boolean":
+		return false
+	}
+	return nil
+}
+
+// flatten resolves s and merges the branches of its allOf into it, so that
+// properties and constraints declared across the branches are all in one
+// schema. Where branches disagree, the first to declare a keyword wins.
+func flatten(s Schema) Schema {
+	s = resolve(s)
+	branches := subschemas(s["allOf"])
+	if len(branches) == 0 {
+		return s
+	}
+
+	merged := Schema{}
+	properties := map[string]interface{}{}
+	merge := func(s Schema) {
+		for key, value := range s {
+			switch key {
+			case "allOf":
+			case "properties":
+				own, _ := value.(map[string]interface{})
+				for name, property := range own {
+					properties[name] = property
+				}
+			default:
+				if _, ok := merged[key]; !ok {
+					merged[key] = value
+				}
+			}
+		}
+	}
+	merge(s)
+	for _, branch := range branches {
+		merge(flatten(branch))
+	}
+	if len(properties) > 0 {
+		merged["properties"] = properties
+	}
+	return merged
+}
+
+// patternExample returns a string matching a simple pattern made of literal
+// characters and character classes with optional quantifiers, such as
+// ^0x[a-fA-F0-9]{64}$. Each class is filled with its first character.
+func patternExample(pattern string) string {
+	pattern = strings.TrimSuffix(strings.TrimPrefix(pattern, "^"), "$")
+	result := strings.Builder{}
+	for i := 0; i < len(pattern); {
+		atom := string(pattern[i])
+		i++
+		if atom == "[" {
+			end := strings.IndexByte(pattern[i:], ']')
+			if end < 0 {
+				break
+			}
+			class := strings.TrimPrefix(pattern[i:i+end], "^")
+			atom = class[:1]
+			i += end + 1
+		} else if atom == "\\" && i < len(pattern) {
+			atom = string(pattern[i])
+			i++
+		}
+
+		count := 1
+		if i < len(pattern) {
+			switch pattern[i] {
+			case '{':
+				end := strings.IndexByte(pattern[i:], '}')
+				if end < 0 {
+					break
+				}
+				bounds := strings.Split(pattern[i+1:i+end], ",")
+				count, _ = strconv.Atoi(bounds[0])
+				i += end + 1
+			case '+':
+				i++
+			case '*', '?':
+				count = 0
+				i++
+			}
+		}
+		result.WriteString(strings.Repeat(atom, count))
+	}
+	return result.String()
+}
+
+// copyValue deep-copies a value from the spec document, converting the
+// integers the YAML decoder produces to the float64s encoding/json produces.
+func copyValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			m[key] = copyValue(value)
+		}
+		return m
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, value := range v {
+			list[i] = copyValue(value)
+		}
+		return list
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	}
+	return v
+}
@@ -0,0 +1,51 @@
+// +build ignore
+
+// gen.go writes the bundled Ethereum 2.0 API specification into a Go source
+// file so that it is available to the apispec package at runtime. Run it with
+// `go generate ./pkg/apispec` whenever pkg/eth2spec/api/openapi.yaml changes.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	specPath = "../eth2spec/api/openapi.yaml"
+	outPath  = "openapi_gen.go"
+)
+
+func main() {
+	spec, err := ioutil.ReadFile(specPath)
+	if err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("// Code generated by gen.go from " + specPath + ". DO NOT EDIT.\n\n")
+	out.WriteString("package apispec\n\n")
+	out.WriteString("// openapiYAML is the bundled Ethereum 2.0 API specification.\n")
+	out.WriteString("const openapiYAML = \"\" +\n")
+
+	lines := strings.SplitAfter(string(spec), "\n")
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		out.WriteString("\t" + strconv.Quote(line))
+		if i < len(lines)-1 && lines[i+1] != "" {
+			out.WriteString(" +")
+		}
+		out.WriteString("\n")
+	}
+
+	if err := ioutil.WriteFile(outPath, out.Bytes(), 0644); err != nil {
+		fmt.Printf("%s\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,182 @@
+package apispec
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Route is an operation declared in the spec.
+type Route struct {
+	// Method is the upper case HTTP method of the operation, e.g. "GET".
+	Method string
+	// Path is the path template the operation is served on, e.g.
+	// "/eth/v1/beacon/states/{state_id}/root".
+	Path string
+	// OperationID is the spec's unique name for the operation, e.g.
+	// "getStateRoot".
+	OperationID string
+
+	operation map[string]interface{}
+	segments  []string
+}
+
+// PathParams maps the names of path parameters to the values found for them
+// in a request path.
+type PathParams map[string]string
+
+func newRoute(method, path, operationId string, operation map[string]interface{}) Route {
+	return Route{
+		Method:      method,
+		Path:        path,
+		OperationID: operationId,
+		operation:   operation,
+		segments:    splitPath(path),
+	}
+}
+
+// Response describes what the spec allows an operation to respond with for a
+// given status code.
+type Response struct {
+	// Status is the key of the response in the operation's responses object,
+	// e.g. "200" or "default".
+	Status string
+	// Content maps the media types the response may be served as to the
+	// schema of the body for that media type. It is empty for responses
+	// without a body.
+	Content map[string]Schema
+}
+
+// Response returns the response the spec declares for the operation and status
+// code, falling back to the operation's default response if it has one.
+func (r Route) Response(statusCode int) (Response, bool) {
+	responses, _ := r.operation["responses"].(map[string]interface{})
+
+	for _, status := range []string{strconv.Itoa(statusCode), "default"} {
+		response, ok := responses[status].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		response = resolve(response)
+
+		result := Response{Status: status, Content: map[string]Schema{}}
+		content, _ := response["content"].(map[string]interface{})
+		for mediaType, mediaTypeObject := range content {
+			mediaTypeObject, _ := mediaTypeObject.(map[string]interface{})
+			schema, _ := mediaTypeObject["schema"].(map[string]interface{})
+			result.Content[mediaType] = schema
+		}
+		return result, true
+	}
+
+	return Response{}, false
+}
+
+// RequestBody returns the schema of the JSON request body the spec declares
+// for the operation, if it declares one.
+func (r Route) RequestBody() (Schema, bool) {
+	requestBody, ok := r.operation["requestBody"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	requestBody = resolve(requestBody)
+
+	content, _ := requestBody["content"].(map[string]interface{})
+	mediaTypeObject, ok := content["application/json"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	schema, ok := mediaTypeObject["schema"].(map[string]interface{})
+	return schema, ok
+}
+
+// Statuses returns the status codes the spec declares for the operation.
+func (r Route) Statuses() []string {
+	responses, _ := r.operation["responses"].(map[string]interface{})
+	return sortedKeys(responses)
+}
+
+// Routes returns every operation declared in the spec.
+func Routes() []Route {
+	result := make([]Route, len(routes))
+	copy(result, routes)
+	return result
+}
+
+// MatchRoute finds the operation serving the request path for the given
+// method, and extracts its path params. Request paths may carry a base-path
+// prefix in front of the spec's path, for example "/api/eth/v1/node/health".
+//
+// When several routes match, the one with the most literal segments wins, so
+// "/eth/v1/beacon/states/head/root" resolves to getStateRoot rather than to
+// any route with a parameter in the place of "root".
+func MatchRoute(method, path string) (Route, PathParams, bool) {
+	method = strings.ToUpper(method)
+	segments := splitPath(path)
+
+	var best Route
+	var bestParams PathParams
+	bestScore := -1
+	for _, route := range routes {
+		if route.Method != method {
+			continue
+		}
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if score := route.literals(); score > bestScore {
+			best, bestParams, bestScore = route, params, score
+		}
+	}
+
+	return best, bestParams, bestScore >= 0
+}
+
+// match reports whether the trailing segments of a request path satisfy the
+// route's path template, and returns the path params found in them.
+func (r Route) match(segments []string) (PathParams, bool) {
+	if len(segments) < len(r.segments) {
+		return nil, false
+	}
+	segments = segments[len(segments)-len(r.segments):]
+
+	params := PathParams{}
+	for i, templateSegment := range r.segments {
+		if name, ok := paramName(templateSegment); ok {
+			if segments[i] == "" {
+				return nil, false
+			}
+			params[name] = segments[i]
+			continue
+		}
+		if templateSegment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// literals counts the segments of the route's path which are not params.
+func (r Route) literals() int {
+	count := 0
+	for _, segment := range r.segments {
+		if _, ok := paramName(segment); !ok {
+			count++
+		}
+	}
+	return count
+}
+
+// paramName returns the name of the path param in a template segment such as
+// "{state_id}".
+func paramName(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+func splitPath(path string) []string {
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
@@ -0,0 +1,496 @@
+package apispec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Schema is a schema object from the spec, in the generic form produced by
+// parseDocument.
+type Schema map[string]interface{}
+
+// Violation describes one way in which a JSON value fails to satisfy a Schema.
+type Violation struct {
+	// Pointer is the JSON pointer (RFC 6901) of the offending value, relative
+	// to the root of the validated document. The root itself is "".
+	Pointer string
+	// Message describes what is wrong with the value.
+	Message string
+}
+
+func (v Violation) String() string {
+	pointer := v.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return fmt.Sprintf("%s: %s", pointer, v.Message)
+}
+
+// SchemaError is returned when a JSON document does not satisfy a Schema. It
+// lists every violation found.
+type SchemaError struct {
+	Violations []Violation
+}
+
+func (e SchemaError) Error() string {
+	lines := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		lines[i] = violation.String()
+	}
+	return fmt.Sprintf("Response does not satisfy the spec schema:\n%s", strings.Join(lines, "\n"))
+}
+
+// Validate checks a JSON value, decoded into generic types by encoding/json,
+// against the schema. It returns every violation found, or nil if the value
+// satisfies the schema.
+func (s Schema) Validate(value interface{}) []Violation {
+	v := validator{}
+	violations := v.validate(s, value, "")
+	if len(violations) == 0 {
+		return nil
+	}
+	return violations
+}
+
+// ValidateStrict is like Validate, but additionally requires objects to have
+// exactly the properties their schema declares: unknown properties and missing
+// properties are both violations.
+//
+// The bundled spec does not list required properties, so strict validation
+// treats every declared property as required, except those the spec describes
+// as "Optional" (such as the stacktraces of error responses).
+func (s Schema) ValidateStrict(value interface{}) []Violation {
+	v := validator{strict: true}
+	violations := v.validate(s, value, "")
+	if len(violations) == 0 {
+		return nil
+	}
+	return violations
+}
+
+// ValidateJSON decodes JSON bytes and validates them against the schema.
+func (s Schema) ValidateJSON(data []byte) ([]Violation, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return s.Validate(value), nil
+}
+
+// ValidateJSONStrict decodes JSON bytes and validates them strictly against
+// the schema.
+func (s Schema) ValidateJSONStrict(data []byte) ([]Violation, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return s.ValidateStrict(value), nil
+}
+
+// validator walks a JSON value alongside a schema, collecting violations.
+type validator struct {
+	strict bool
+}
+
+func (v validator) validate(s Schema, value interface{}, pointer string) []Violation {
+	violations := v.validateBranch(s, value, pointer)
+
+	// The properties of an object may be declared across the branches of an
+	// allOf, so the set of declared properties is only checked here, once
+	// for the whole schema, rather than for each branch.
+	if object, ok := value.(map[string]interface{}); ok && v.strict {
+		violations = append(violations, v.validateDeclared(s, object, pointer)...)
+	}
+
+	return violations
+}
+
+// validateBranch validates value against s, and against all branches of an
+// allOf in s, without checking the set of declared properties.
+func (v validator) validateBranch(s Schema, value interface{}, pointer string) []Violation {
+	s = resolve(s)
+	violations := []Violation{}
+
+	for _, subschema := range subschemas(s["allOf"]) {
+		violations = append(violations, v.validateBranch(subschema, value, pointer)...)
+	}
+	if options := subschemas(s["anyOf"]); len(options) > 0 {
+		if v.matching(options, value, pointer) == 0 {
+			violations = append(violations, Violation{pointer, "does not match any of the allowed schemas (anyOf)"})
+		}
+	}
+	if options := subschemas(s["oneOf"]); len(options) > 0 {
+		if matches := v.matching(options, value, pointer); matches != 1 {
+			violations = append(violations, Violation{pointer, fmt.Sprintf("matches %d of the allowed schemas, expected exactly 1 (oneOf)", matches)})
+		}
+	}
+
+	if value == nil {
+		if nullable, _ := s["nullable"].(bool); !nullable && s["type"] != nil {
+			violations = append(violations, Violation{pointer, fmt.Sprintf("is null, expected %s", s["type"])})
+		}
+		return violations
+	}
+
+	if schemaType, ok := s["type"].(string); ok {
+		if !hasType(value, schemaType) {
+			violations = append(violations, Violation{pointer, fmt.Sprintf("is %s, expected %s", typeName(value), schemaType)})
+			return violations
+		}
+	}
+
+	if enum, ok := s["enum"].([]interface{}); ok && !inEnum(value, enum) {
+		violations = append(violations, Violation{pointer, fmt.Sprintf("%s is not one of the allowed values %s", describe(value), describe(enum))})
+	}
+
+	switch value := value.(type) {
+	case string:
+		violations = append(violations, v.validateString(s, value, pointer)...)
+	case []interface{}:
+		violations = append(violations, v.validateArray(s, value, pointer)...)
+	case map[string]interface{}:
+		violations = append(violations, v.validateObject(s, value, pointer)...)
+	}
+
+	return violations
+}
+
+func (v validator) validateString(s Schema, value string, pointer string) []Violation {
+	violations := []Violation{}
+
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := compilePattern(pattern)
+		if err == nil && !re.MatchString(value) {
+			violations = append(violations, Violation{pointer, fmt.Sprintf("%q does not match pattern %s", value, pattern)})
+		}
+	}
+	if minLength, ok := number(s["minLength"]); ok && float64(len(value)) < minLength {
+		violations = append(violations, Violation{pointer, fmt.Sprintf("has length %d, minimum is %v", len(value), minLength)})
+	}
+	if maxLength, ok := number(s["maxLength"]); ok && float64(len(value)) > maxLength {
+		violations = append(violations, Violation{pointer, fmt.Sprintf("has length %d, maximum is %v", len(value), maxLength)})
+	}
+
+	return violations
+}
+
+func (v validator) validateArray(s Schema, value []interface{}, pointer string) []Violation {
+	violations := []Violation{}
+
+	if minItems, ok := number(s["minItems"]); ok && float64(len(value)) < minItems {
+		violations = append(violations, Violation{pointer, fmt.Sprintf("has %d items, minimum is %v", len(value), minItems)})
+	}
+	if maxItems, ok := number(s["maxItems"]); ok && float64(len(value)) > maxItems {
+		violations = append(violations, Violation{pointer, fmt.Sprintf("has %d items, maximum is %v", len(value), maxItems)})
+	}
+	if unique, _ := s["uniqueItems"].(bool); unique {
+		for i := range value {
+			for j := i + 1; j < len(value); j++ {
+				if reflect.DeepEqual(value[i], value[j]) {
+					violations = append(violations, Violation{pointer, fmt.Sprintf("items %d and %d are equal, items must be unique", i, j)})
+				}
+			}
+		}
+	}
+	if items, ok := s["items"].(map[string]interface{}); ok {
+		for i, item := range value {
+			violations = append(violations, v.validate(items, item, fmt.Sprintf("%s/%d", pointer, i))...)
+		}
+	}
+
+	return violations
+}
+
+func (v validator) validateObject(s Schema, value map[string]interface{}, pointer string) []Violation {
+	violations := []Violation{}
+
+	for _, name := range stringList(s["required"]) {
+		if _, ok := value[name]; !ok {
+			violations = append(violations, Violation{pointer + "/" + EscapePointer(name), "is required but missing"})
+		}
+	}
+
+	properties, _ := s["properties"].(map[string]interface{})
+	for _, name := range sortedKeys(value) {
+		propertyPointer := pointer + "/" + EscapePointer(name)
+		if property, ok := properties[name].(map[string]interface{}); ok {
+			violations = append(violations, v.validate(property, value[name], propertyPointer)...)
+			continue
+		}
+		switch additional := s["additionalProperties"].(type) {
+		case bool:
+			if !additional {
+				violations = append(violations, Violation{propertyPointer, "is not a property allowed by the schema"})
+			}
+		case map[string]interface{}:
+			violations = append(violations, v.validate(additional, value[name], propertyPointer)...)
+		}
+	}
+
+	return violations
+}
+
+// validateDeclared reports properties of an object which its schema does not
+// declare, and declared properties which the object is missing.
+func (v validator) validateDeclared(s Schema, value map[string]interface{}, pointer string) []Violation {
+	properties, open := declaredProperties(s)
+	if open {
+		return nil
+	}
+
+	violations := []Violation{}
+	for _, name := range sortedKeys(value) {
+		if _, ok := properties[name]; !ok {
+			violations = append(violations, Violation{pointer + "/" + EscapePointer(name), "is not declared by the spec schema"})
+		}
+	}
+	for _, name := range sortedKeys(properties) {
+		if _, ok := value[name]; !ok && !isOptional(properties[name].(Schema)) {
+			violations = append(violations, Violation{pointer + "/" + EscapePointer(name), "is declared by the spec schema but missing"})
+		}
+	}
+
+	return violations
+}
+
+// declaredProperties collects the properties declared by a schema and the
+// branches of its allOf. A schema is open if it declares no properties at all
+// (a free-form object, like the data of getSpec) or allows additional ones.
+func declaredProperties(s Schema) (map[string]interface{}, bool) {
+	properties := map[string]interface{}{}
+	open := collectProperties(s, properties)
+	return properties, open || len(properties) == 0
+}
+
+// collectProperties adds the properties declared by s and the branches of its
+// allOf to properties, and reports whether s allows additional properties.
+func collectProperties(s Schema, properties map[string]interface{}) bool {
+	s = resolve(s)
+	open := false
+
+	if own, ok := s["properties"].(map[string]interface{}); ok {
+		for name, property := range own {
+			if property, ok := property.(map[string]interface{}); ok {
+				properties[name] = Schema(property)
+			}
+		}
+	}
+	if additional, ok := s["additionalProperties"]; ok && additional != false {
+		open = true
+	}
+	for _, subschema := range subschemas(s["allOf"]) {
+		if collectProperties(subschema, properties) {
+			open = true
+		}
+	}
+
+	return open
+}
+
+// isOptional reports whether the spec describes a property as optional.
+func isOptional(s Schema) bool {
+	s = resolve(s)
+	if description, ok := s["description"].(string); ok && strings.HasPrefix(description, "Optional") {
+		return true
+	}
+	for _, subschema := range subschemas(s["allOf"]) {
+		if isOptional(subschema) {
+			return true
+		}
+	}
+	return false
+}
+
+// matching counts the schemas which value satisfies.
+func (v validator) matching(options []Schema, value interface{}, pointer string) int {
+	matches := 0
+	for _, option := range options {
+		if len(v.validate(option, value, pointer)) == 0 {
+			matches++
+		}
+	}
+	return matches
+}
+
+// resolve follows $ref until it reaches a schema that is not a reference.
+func resolve(s Schema) Schema {
+	for i := 0; i < 32; i++ {
+		ref, ok := s["$ref"].(string)
+		if !ok {
+			return s
+		}
+		target, ok := lookupPointer(document, strings.TrimPrefix(ref, "#")).(map[string]interface{})
+		if !ok {
+			return Schema{}
+		}
+		s = target
+	}
+	return s
+}
+
+// lookupPointer returns the value at a JSON pointer within a document.
+func lookupPointer(doc interface{}, pointer string) interface{} {
+	if pointer == "" {
+		return doc
+	}
+	current := doc
+	for _, token := range splitPointer(pointer) {
+		switch node := current.(type) {
+		case map[string]interface{}:
+			current = node[token]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+func splitPointer(pointer string) []string {
+	tokens := strings.Split(strings.TrimPrefix(pointer, "/"), "/")
+	for i, token := range tokens {
+		token = strings.Replace(token, "~1", "/", -1)
+		tokens[i] = strings.Replace(token, "~0", "~", -1)
+	}
+	return tokens
+}
+
+// EscapePointer escapes a token of a JSON pointer (RFC 6901), like a property
+// name, so that any "~" or "/" in it is not taken for an escape or separator.
+func EscapePointer(token string) string {
+	token = strings.Replace(token, "~", "~0", -1)
+	return strings.Replace(token, "/", "~1", -1)
+}
+
+func subschemas(v interface{}) []Schema {
+	list, _ := v.([]interface{})
+	result := []Schema{}
+	for _, item := range list {
+		if s, ok := item.(map[string]interface{}); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func hasType(value interface{}, schemaType string) bool {
+	switch schemaType {
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := value.([]interface{})
+		return ok
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "number":
+		_, ok := number(value)
+		return ok
+	case "integer":
+		n, ok := number(value)
+		return ok && n == math.Trunc(n)
+	}
+	return true
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64, json.Number:
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+func number(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case int:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func inEnum(value interface{}, enum []interface{}) bool {
+	for _, allowed := range enum {
+		if reflect.DeepEqual(value, allowed) {
+			return true
+		}
+		// YAML integers decode as int while JSON numbers decode as float64.
+		a, aok := number(value)
+		b, bok := number(allowed)
+		if aok && bok && a == b {
+			return true
+		}
+	}
+	return false
+}
+
+func describe(v interface{}) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func stringList(v interface{}) []string {
+	list, _ := v.([]interface{})
+	result := []string{}
+	for _, item := range list {
+		if s, ok := item.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// patterns caches compiled schema patterns, which are shared by many schemas.
+var patterns = struct {
+	sync.Mutex
+	compiled map[string]*regexp.Regexp
+}{compiled: map[string]*regexp.Regexp{}}
+
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	patterns.Lock()
+	defer patterns.Unlock()
+
+	if re, ok := patterns.compiled[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	patterns.compiled[pattern] = re
+	return re, nil
+}
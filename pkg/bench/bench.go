@@ -0,0 +1,268 @@
+// package bench replays test cases against a target as a load test, and
+// measures how quickly the target responds to them.
+package bench
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// Opts configures the workload of a benchmark.
+type Opts struct {
+	// Requests is the number of times each case is executed. If neither
+	// Requests nor Duration is set, each case is executed once.
+	Requests int
+	// Duration is how long to keep executing cases for. If Requests is also
+	// set, the benchmark ends at whichever limit is reached first.
+	Duration time.Duration
+	// Concurrency is the maximum number of requests in flight at once.
+	// Values less than one mean one.
+	Concurrency int
+	// Rate is the number of requests started per second, across all cases.
+	// Zero means as many as Concurrency allows.
+	Rate float64
+	// RequestTimeout bounds each request. Zero means requests are only
+	// bounded by the context given to Run.
+	RequestTimeout time.Duration
+}
+
+// RouteStats are the measurements for the requests to one operation.
+type RouteStats struct {
+	// Method and Route identify the operation, with Route being the path
+	// template from the spec, e.g. "/eth/v1/beacon/headers/{block_id}".
+	Method string
+	Route  string
+	// Requests is the number of requests made, and Errors the number of
+	// them which failed. A request fails if no response is received, or
+	// if the response's status code is not the case's expectedRespStatus.
+	// Cases without an expectedRespStatus fail on any error response.
+	Requests int
+	Errors   int
+	// Throughput is the number of requests completed per second over the
+	// whole benchmark.
+	Throughput float64
+	// P50, P90 and P99 are latency percentiles, and Max is the highest
+	// latency. Failed requests are included.
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+	Max time.Duration
+
+	latencies []time.Duration
+}
+
+// ErrorRate returns the fraction of requests which failed.
+func (s RouteStats) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Errors) / float64(s.Requests)
+}
+
+// Result is the outcome of a benchmark.
+type Result struct {
+	// Elapsed is the time from the first request being started to the last
+	// one completing.
+	Elapsed time.Duration
+	// Routes holds the measurements for each operation, sorted by route and
+	// then method.
+	Routes []RouteStats
+	// Total holds the measurements for all requests together.
+	Total RouteStats
+}
+
+// Run executes cases against the target in ctx, taking turns between them,
+// until the workload described by opts is complete or ctx is done. Await
+// slots are not waited for, so the caller should make sure that the target
+// has synced them first. Event stream cases and scenarios are skipped, as
+// they have no single response to time.
+func Run(ctx context.Context, cases []*testcases.Case, opts Opts) Result {
+	replayed := []*testcases.Case{}
+	for _, c := range cases {
+		if c.Config.Events == nil && len(c.Config.Steps) == 0 {
+			replayed = append(replayed, c)
+		}
+	}
+	if len(replayed) == 0 {
+		return Result{}
+	}
+
+	if opts.Requests == 0 && opts.Duration == 0 {
+		opts.Requests = 1
+	}
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	jobs := make(chan *testcases.Case)
+	go dispatch(ctx, replayed, opts, jobs)
+
+	mu := sync.Mutex{}
+	stats := map[string]*RouteStats{}
+	total := &RouteStats{}
+
+	start := time.Now()
+	wg := sync.WaitGroup{}
+	for i := 0; i < opts.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				latency, ok := execute(ctx, c, opts.RequestTimeout)
+				method, route := operation(c)
+
+				mu.Lock()
+				key := method + " " + route
+				if _, exists := stats[key]; !exists {
+					stats[key] = &RouteStats{Method: method, Route: route}
+				}
+				for _, s := range []*RouteStats{stats[key], total} {
+					s.Requests++
+					if !ok {
+						s.Errors++
+					}
+					s.latencies = append(s.latencies, latency)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	result := Result{Elapsed: time.Since(start)}
+	for _, s := range stats {
+		result.Routes = append(result.Routes, s.summarize(result.Elapsed))
+	}
+	sort.Slice(result.Routes, func(i, j int) bool {
+		if result.Routes[i].Route != result.Routes[j].Route {
+			return result.Routes[i].Route < result.Routes[j].Route
+		}
+		return result.Routes[i].Method < result.Routes[j].Method
+	})
+	result.Total = total.summarize(result.Elapsed)
+
+	return result
+}
+
+// dispatch sends cases to jobs in turn, at the rate and for as long as opts
+// describe, then closes jobs.
+func dispatch(ctx context.Context, cases []*testcases.Case, opts Opts, jobs chan<- *testcases.Case) {
+	defer close(jobs)
+
+	var stop <-chan time.Time
+	if opts.Duration > 0 {
+		timer := time.NewTimer(opts.Duration)
+		defer timer.Stop()
+		stop = timer.C
+	}
+
+	var interval time.Duration
+	if opts.Rate > 0 {
+		interval = time.Duration(float64(time.Second) / opts.Rate)
+	}
+
+	next := time.Now()
+	for i := 0; opts.Requests == 0 || i < opts.Requests*len(cases); i++ {
+		if interval > 0 {
+			// Requests which could not be started on time, because every
+			// worker was busy, are not made up for with a burst.
+			if now := time.Now(); next.Before(now) {
+				next = now
+			}
+			select {
+			case <-time.After(time.Until(next)):
+			case <-stop:
+				return
+			case <-ctx.Done():
+				return
+			}
+			next = next.Add(interval)
+		}
+
+		select {
+		case jobs <- cases[i%len(cases)]:
+		case <-stop:
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// execute executes a Case's operation once, and returns how long the target
+// took to respond and whether the request succeeded.
+func execute(ctx context.Context, c *testcases.Case, timeout time.Duration) (time.Duration, bool) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	result, err := c.Do(ctx)
+	latency := time.Since(start)
+
+	if result == nil || result.StatusCode == nil {
+		return latency, false
+	}
+	if c.Config.ExpectedRespStatus != 0 {
+		return latency, *result.StatusCode == c.Config.ExpectedRespStatus
+	}
+	return latency, err == nil
+}
+
+// operation returns the method and the spec path template of a Case's
+// operation. Routes which are not in the spec are returned as they are.
+func operation(c *testcases.Case) (string, string) {
+	route, err := url.Parse(c.Config.Route)
+	if err != nil {
+		return c.Config.Method, c.Config.Route
+	}
+
+	specRoute, _, ok := apispec.MatchRoute(c.Config.Method, route.Path)
+	if !ok {
+		return c.Config.Method, route.Path
+	}
+	return specRoute.Method, specRoute.Path
+}
+
+// summarize computes the throughput and latency percentiles of the requests
+// recorded in s.
+func (s RouteStats) summarize(elapsed time.Duration) RouteStats {
+	sort.Slice(s.latencies, func(i, j int) bool {
+		return s.latencies[i] < s.latencies[j]
+	})
+
+	if elapsed > 0 {
+		s.Throughput = float64(s.Requests) / elapsed.Seconds()
+	}
+	s.P50 = percentile(s.latencies, 50)
+	s.P90 = percentile(s.latencies, 90)
+	s.P99 = percentile(s.latencies, 99)
+	if len(s.latencies) > 0 {
+		s.Max = s.latencies[len(s.latencies)-1]
+	}
+	s.latencies = nil
+
+	return s
+}
+
+// percentile returns the p-th percentile of sorted latencies, by the nearest
+// rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,215 @@
+package mock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Chain is a deterministic beacon chain, generated rather than synced. There is
+// a block in every slot up to the head, which is the slot the wall clock is
+// in. Roots, keys and signatures are hashes of what they belong to, so the same
+// Chain always has the same contents.
+type Chain struct {
+	Genesis        time.Time
+	SecondsPerSlot int
+	SlotsPerEpoch  int
+	// Validators is the number of validators, all of which are active from
+	// genesis.
+	Validators int
+}
+
+// HeadSlot returns the slot of the chain's head, the slot the wall clock is
+// in. It is zero before genesis.
+func (c *Chain) HeadSlot() int {
+	since := time.Since(c.Genesis)
+	if since < 0 {
+		return 0
+	}
+	return int(since / c.slotDuration())
+}
+
+// SlotStart returns the time at which slot begins.
+func (c *Chain) SlotStart(slot int) time.Time {
+	return c.Genesis.Add(time.Duration(slot) * c.slotDuration())
+}
+
+func (c *Chain) slotDuration() time.Duration {
+	return time.Duration(c.SecondsPerSlot) * time.Second
+}
+
+// Epoch returns the epoch of slot.
+func (c *Chain) Epoch(slot int) int {
+	return slot / c.SlotsPerEpoch
+}
+
+// epochStart returns the first slot of epoch.
+func (c *Chain) epochStart(epoch int) int {
+	return epoch * c.SlotsPerEpoch
+}
+
+// checkpoints returns the finalized, current justified and previous justified
+// epochs of the state at slot. The chain justifies every epoch as soon as it
+// ends, and finalizes it an epoch later.
+func (c *Chain) checkpoints(slot int) (finalized, justified, previousJustified int) {
+	epoch := c.Epoch(slot)
+	return atLeastZero(epoch - 2), atLeastZero(epoch - 1), atLeastZero(epoch - 2)
+}
+
+// checkpoint returns the checkpoint of epoch, as it appears in responses.
+func (c *Chain) checkpoint(epoch int) map[string]interface{} {
+	return map[string]interface{}{
+		"epoch": strconv.Itoa(epoch),
+		"root":  c.BlockRoot(c.epochStart(epoch)),
+	}
+}
+
+// BlockRoot returns the root of the block in slot.
+func (c *Chain) BlockRoot(slot int) string {
+	return hash("block", slot, 32)
+}
+
+// StateRoot returns the root of the state after the block in slot.
+func (c *Chain) StateRoot(slot int) string {
+	return hash("state", slot, 32)
+}
+
+// Pubkey returns the public key of the validator with index.
+func (c *Chain) Pubkey(index int) string {
+	return hash("pubkey", index, 48)
+}
+
+// Sign returns the signature of message, in the generic types encoding/json
+// produces. The chain has no keys: a signature is a hash of the message it
+// signs, so only signatures made by Sign are valid.
+func (c *Chain) Sign(message interface{}) string {
+	data, _ := json.Marshal(message)
+	sum := sha256.Sum256(data)
+	return hash("signature/"+hex.EncodeToString(sum[:]), 0, 96)
+}
+
+// invalidSignature returns the JSON pointer of the first object within v whose
+// signature was not made by Sign, if any. The message an object signs is its
+// message property, or its data property for attestations.
+func (c *Chain) invalidSignature(v interface{}, pointer string) (string, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if signature, ok := v["signature"]; ok {
+			message, ok := v["message"]
+			if !ok {
+				message = v["data"]
+			}
+			if signature != c.Sign(message) {
+				return pointer, true
+			}
+		}
+		for _, key := range sortedKeys(v) {
+			if invalid, ok := c.invalidSignature(v[key], pointer+"/"+key); ok {
+				return invalid, true
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			if invalid, ok := c.invalidSignature(item, fmt.Sprintf("%s/%d", pointer, i)); ok {
+				return invalid, true
+			}
+		}
+	}
+	return "", false
+}
+
+// proposer returns the index of the validator proposing the block in slot.
+func (c *Chain) proposer(slot int) int {
+	return slot % c.Validators
+}
+
+// committee returns the indices of the validators in the single committee of
+// slot. Every validator is in one committee per epoch.
+func (c *Chain) committee(slot int) []int {
+	validators := []int{}
+	for index := slot % c.SlotsPerEpoch; index < c.Validators; index += c.SlotsPerEpoch {
+		validators = append(validators, index)
+	}
+	return validators
+}
+
+// blockSlot returns the slot of the block identified by a block_id path param:
+// head, genesis, finalized, a slot or a block root. It is false if there is no
+// such block yet.
+func (c *Chain) blockSlot(id string) (int, bool) {
+	head := c.HeadSlot()
+	return c.findSlot(id, head, c.BlockRoot)
+}
+
+// stateSlot returns the slot of the state identified by a state_id path param:
+// head, genesis, finalized, justified, a slot or a state root. It is false if
+// there is no such state yet.
+func (c *Chain) stateSlot(id string) (int, bool) {
+	head := c.HeadSlot()
+	if id == "justified" {
+		_, justified, _ := c.checkpoints(head)
+		return c.epochStart(justified), true
+	}
+	return c.findSlot(id, head, c.StateRoot)
+}
+
+// findSlot resolves the identifiers block and state ids have in common, where
+// root gives the root of the block or state in a slot.
+func (c *Chain) findSlot(id string, head int, root func(int) string) (int, bool) {
+	switch id {
+	case "head":
+		return head, true
+	case "genesis":
+		return 0, true
+	case "finalized":
+		finalized, _, _ := c.checkpoints(head)
+		return c.epochStart(finalized), true
+	}
+
+	if strings.HasPrefix(id, "0x") {
+		for slot := head; slot >= 0; slot-- {
+			if root(slot) == id {
+				return slot, true
+			}
+		}
+		return 0, false
+	}
+
+	slot, err := strconv.Atoi(id)
+	if err != nil || slot < 0 || slot > head {
+		return 0, false
+	}
+	return slot, true
+}
+
+// hash returns a hex-encoded pseudo-random value of size bytes, derived from
+// kind and n.
+func hash(kind string, n int, size int) string {
+	data := []byte{}
+	for i := 0; len(data) < size; i++ {
+		sum := sha256.Sum256([]byte(fmt.Sprintf("%s/%d/%d", kind, n, i)))
+		data = append(data, sum[:]...)
+	}
+	return "0x" + hex.EncodeToString(data[:size])
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func atLeastZero(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,81 @@
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// serveEvents serves the event stream. As each slot begins, it sends head,
+// block and attestation events for the new block, and a finalized_checkpoint
+// event if the slot begins an epoch. The chain never reorgs and no validator
+// ever exits, so there are no chain_reorg or voluntary_exit events.
+func (n *Node) serveEvents(w http.ResponseWriter, r *http.Request) {
+	topics := map[string]bool{}
+	for _, topic := range values(request{query: r.URL.Query()}, "topics") {
+		topics[topic] = true
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeResponse(w, errorResponse(http.StatusInternalServerError, "Streaming is not supported"))
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	c := n.Chain
+	for slot := c.HeadSlot() + 1; ; slot++ {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(time.Until(c.SlotStart(slot))):
+		}
+
+		events := []struct {
+			topic string
+			data  interface{}
+		}{
+			{"head", map[string]interface{}{
+				"slot":             strconv.Itoa(slot),
+				"block":            c.BlockRoot(slot),
+				"state":            c.StateRoot(slot),
+				"epoch_transition": slot%c.SlotsPerEpoch == 0,
+			}},
+			{"block", map[string]interface{}{
+				"slot":  strconv.Itoa(slot),
+				"block": c.BlockRoot(slot),
+			}},
+			{"attestation", c.attestation(slot - 1)},
+		}
+		if slot%c.SlotsPerEpoch == 0 {
+			finalized, _, _ := c.checkpoints(slot)
+			events = append(events, struct {
+				topic string
+				data  interface{}
+			}{"finalized_checkpoint", map[string]interface{}{
+				"block": c.BlockRoot(c.epochStart(finalized)),
+				"state": c.StateRoot(c.epochStart(finalized)),
+				"epoch": strconv.Itoa(finalized),
+			}})
+		}
+
+		for _, event := range events {
+			if !topics[event.topic] {
+				continue
+			}
+			data, err := json.Marshal(event.data)
+			if err != nil {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.topic, data); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+	}
+}
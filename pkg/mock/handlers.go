@@ -0,0 +1,495 @@
+package mock
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+)
+
+// Version is the version the Node reports for itself.
+const Version = "eth2-comply-mock/v0.1.0"
+
+// Balances of the generated chain's validators, in Gwei.
+const (
+	maxEffectiveBalance = "32000000000"
+	farFutureEpoch      = "18446744073709551615"
+)
+
+// handlers map the IDs of operations whose responses depend on the chain to
+// functions deriving their responses from it. Other operations are answered
+// with the spec's example.
+var handlers = map[string]func(c *Chain, req request) response{
+	"getGenesis": func(c *Chain, req request) response {
+		return ok(map[string]interface{}{
+			"genesis_time":            strconv.FormatInt(c.Genesis.Unix(), 10),
+			"genesis_validators_root": hash("validators", 0, 32),
+			"genesis_fork_version":    "0x00000000",
+		})
+	},
+	"getBlock": func(c *Chain, req request) response {
+		slot, found := c.blockSlot(req.params["block_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "Block not found")
+		}
+		block, isObject := exampleData(req.route).(map[string]interface{})
+		if !isObject {
+			return missingExample(req.route)
+		}
+		message, isObject := block["message"].(map[string]interface{})
+		if !isObject {
+			return missingExample(req.route)
+		}
+		message = c.blockMessage(slot, message)
+		block["message"] = message
+		block["signature"] = c.Sign(message)
+		return ok(block)
+	},
+	"getBlockRoot": func(c *Chain, req request) response {
+		slot, found := c.blockSlot(req.params["block_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "Block not found")
+		}
+		return ok(map[string]interface{}{"root": c.BlockRoot(slot)})
+	},
+	"getBlockAttestations": func(c *Chain, req request) response {
+		slot, found := c.blockSlot(req.params["block_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "Block not found")
+		}
+		return ok(c.blockAttestations(slot))
+	},
+	"getBlockHeader": func(c *Chain, req request) response {
+		slot, found := c.blockSlot(req.params["block_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "Block not found")
+		}
+		return ok(c.header(slot))
+	},
+	"getBlockHeaders": func(c *Chain, req request) response {
+		head := c.HeadSlot()
+		slot := head
+		value := req.query.Get("slot")
+		if value != "" {
+			var err error
+			if slot, err = strconv.Atoi(value); err != nil {
+				return ok([]interface{}{})
+			}
+		}
+		if parent := req.query.Get("parent_root"); parent != "" {
+			parentSlot, found := c.findSlot(parent, head, c.BlockRoot)
+			if !found || value != "" && slot != parentSlot+1 {
+				return ok([]interface{}{})
+			}
+			slot = parentSlot + 1
+		}
+		if slot < 0 || slot > head {
+			return ok([]interface{}{})
+		}
+		return ok([]interface{}{c.header(slot)})
+	},
+	"getStateRoot": func(c *Chain, req request) response {
+		slot, found := c.stateSlot(req.params["state_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		return ok(map[string]interface{}{"root": c.StateRoot(slot)})
+	},
+	"getStateFork": func(c *Chain, req request) response {
+		if _, found := c.stateSlot(req.params["state_id"]); !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		return ok(fork())
+	},
+	"getStateFinalityCheckpoints": func(c *Chain, req request) response {
+		slot, found := c.stateSlot(req.params["state_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		finalized, justified, previousJustified := c.checkpoints(slot)
+		return ok(map[string]interface{}{
+			"previous_justified": c.checkpoint(previousJustified),
+			"current_justified":  c.checkpoint(justified),
+			"finalized":          c.checkpoint(finalized),
+		})
+	},
+	"getStateValidators": func(c *Chain, req request) response {
+		if _, found := c.stateSlot(req.params["state_id"]); !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+
+		indices := []int{}
+		if ids := values(req, "id"); len(ids) > 0 {
+			for _, id := range ids {
+				if index, found := c.validatorIndex(id); found {
+					indices = append(indices, index)
+				}
+			}
+		} else {
+			for index := 0; index < c.Validators; index++ {
+				indices = append(indices, index)
+			}
+		}
+
+		validators := []interface{}{}
+		for _, index := range indices {
+			validator := c.validator(index)
+			status, _ := validator["status"].(string)
+			if statuses := values(req, "status"); len(statuses) > 0 && !hasStatus(status, statuses) {
+				continue
+			}
+			validators = append(validators, validator)
+		}
+		return ok(validators)
+	},
+	"getStateValidator": func(c *Chain, req request) response {
+		if _, found := c.stateSlot(req.params["state_id"]); !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		index, found := c.validatorIndex(req.params["validator_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "Validator not found")
+		}
+		return ok(c.validator(index))
+	},
+	"getEpochCommittees": func(c *Chain, req request) response {
+		stateSlot, found := c.stateSlot(req.params["state_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		epoch, err := strconv.Atoi(req.params["epoch"])
+		if err != nil || epoch < 0 || epoch > c.Epoch(stateSlot)+1 {
+			return errorResponse(http.StatusBadRequest, "Invalid epoch")
+		}
+
+		committees := []interface{}{}
+		for slot := c.epochStart(epoch); slot < c.epochStart(epoch+1); slot++ {
+			if value := req.query.Get("slot"); value != "" && value != strconv.Itoa(slot) {
+				continue
+			}
+			if value := req.query.Get("index"); value != "" && value != "0" {
+				continue
+			}
+			committees = append(committees, map[string]interface{}{
+				"index":      "0",
+				"slot":       strconv.Itoa(slot),
+				"validators": decimals(c.committee(slot)),
+			})
+		}
+		return ok(committees)
+	},
+	"getSpec": func(c *Chain, req request) response {
+		return ok(map[string]interface{}{
+			"SECONDS_PER_SLOT":        strconv.Itoa(c.SecondsPerSlot),
+			"SLOTS_PER_EPOCH":         strconv.Itoa(c.SlotsPerEpoch),
+			"MAX_COMMITTEES_PER_SLOT": "1",
+			"GENESIS_FORK_VERSION":    "0x00000000",
+			"MAX_EFFECTIVE_BALANCE":   maxEffectiveBalance,
+			"FAR_FUTURE_EPOCH":        farFutureEpoch,
+		})
+	},
+	"getForkSchedule": func(c *Chain, req request) response {
+		return ok([]interface{}{fork()})
+	},
+	"getDebugChainHeads": func(c *Chain, req request) response {
+		head := c.HeadSlot()
+		return ok([]interface{}{map[string]interface{}{
+			"root": c.BlockRoot(head),
+			"slot": strconv.Itoa(head),
+		}})
+	},
+	"getState": func(c *Chain, req request) response {
+		slot, found := c.stateSlot(req.params["state_id"])
+		if !found {
+			return errorResponse(http.StatusNotFound, "State not found")
+		}
+		state, isObject := exampleData(req.route).(map[string]interface{})
+		if !isObject {
+			return missingExample(req.route)
+		}
+		state["genesis_time"] = strconv.FormatInt(c.Genesis.Unix(), 10)
+		state["slot"] = strconv.Itoa(slot)
+		state["fork"] = fork()
+		return ok(state)
+	},
+	"getPeers": func(c *Chain, req request) response {
+		peers, isList := exampleData(req.route).([]interface{})
+		if !isList {
+			return missingExample(req.route)
+		}
+		for _, peer := range peers {
+			if peer, isObject := peer.(map[string]interface{}); isObject {
+				peer["state"] = "connected"
+			}
+		}
+		return ok(peers)
+	},
+	"getPeer": func(c *Chain, req request) response {
+		peer, isObject := exampleData(req.route).(map[string]interface{})
+		if !isObject {
+			return missingExample(req.route)
+		}
+		if peer["peer_id"] != req.params["peer_id"] {
+			return errorResponse(http.StatusNotFound, "Peer not found")
+		}
+		peer["state"] = "connected"
+		return ok(peer)
+	},
+	"getSyncingStatus": func(c *Chain, req request) response {
+		return ok(map[string]interface{}{
+			"head_slot":     strconv.Itoa(c.HeadSlot()),
+			"sync_distance": "0",
+		})
+	},
+	"getNodeVersion": func(c *Chain, req request) response {
+		return ok(map[string]interface{}{"version": Version})
+	},
+	"getProposerDuties": func(c *Chain, req request) response {
+		epoch, err := strconv.Atoi(req.params["epoch"])
+		if err != nil || epoch < 0 || epoch > c.Epoch(c.HeadSlot())+1 {
+			return errorResponse(http.StatusBadRequest, "Invalid epoch")
+		}
+
+		duties := []interface{}{}
+		for slot := c.epochStart(epoch); slot < c.epochStart(epoch+1); slot++ {
+			duties = append(duties, map[string]interface{}{
+				"pubkey": c.Pubkey(c.proposer(slot)),
+				"slot":   strconv.Itoa(slot),
+			})
+		}
+		return ok(duties)
+	},
+	"getAttesterDuties": func(c *Chain, req request) response {
+		epoch, err := strconv.Atoi(req.params["epoch"])
+		if err != nil || epoch < 0 || epoch > c.Epoch(c.HeadSlot())+1 {
+			return errorResponse(http.StatusBadRequest, "Invalid epoch")
+		}
+
+		duties := []interface{}{}
+		for _, id := range values(req, "index") {
+			index, err := strconv.Atoi(id)
+			if err != nil || index < 0 || index >= c.Validators {
+				return errorResponse(http.StatusBadRequest, "Invalid validator index "+id)
+			}
+			slot := c.epochStart(epoch) + index%c.SlotsPerEpoch
+			duties = append(duties, map[string]interface{}{
+				"pubkey":                    c.Pubkey(index),
+				"committee_index":           "0",
+				"committee_length":          strconv.Itoa(len(c.committee(slot))),
+				"validator_committee_index": strconv.Itoa(index / c.SlotsPerEpoch),
+				"slot":                      strconv.Itoa(slot),
+			})
+		}
+		return ok(duties)
+	},
+	"produceAttestationData": func(c *Chain, req request) response {
+		slot, err := strconv.Atoi(req.query.Get("slot"))
+		if err != nil || slot < 0 || slot > c.HeadSlot() {
+			return errorResponse(http.StatusBadRequest, "Invalid slot")
+		}
+		if _, err := strconv.Atoi(req.query.Get("committee_index")); err != nil {
+			return errorResponse(http.StatusBadRequest, "Invalid committee index")
+		}
+		data := c.attestationData(slot)
+		data["index"] = req.query.Get("committee_index")
+		return ok(data)
+	},
+	"getAggregatedAttestation": func(c *Chain, req request) response {
+		slot := c.HeadSlot()
+		if value := req.query.Get("slot"); value != "" {
+			var err error
+			if slot, err = strconv.Atoi(value); err != nil || slot < 0 || slot > c.HeadSlot() {
+				return errorResponse(http.StatusBadRequest, "Invalid slot")
+			}
+		}
+		return ok(c.attestation(slot))
+	},
+	"produceBlock": func(c *Chain, req request) response {
+		slot, err := strconv.Atoi(req.params["slot"])
+		if err != nil || slot <= 0 {
+			return errorResponse(http.StatusBadRequest, "Invalid slot")
+		}
+		block, isObject := exampleData(req.route).(map[string]interface{})
+		if !isObject {
+			return missingExample(req.route)
+		}
+		return ok(c.blockMessage(slot, block))
+	},
+}
+
+// ok returns a 200 response with data as the data property of its body.
+func ok(data interface{}) response {
+	return response{
+		status: http.StatusOK,
+		body:   map[string]interface{}{"data": data},
+	}
+}
+
+// exampleData returns the data property of the spec's example body for the 200
+// response of route.
+func exampleData(route apispec.Route) interface{} {
+	body, _ := exampleResponse(route).body.(map[string]interface{})
+	return body["data"]
+}
+
+// missingExample returns a 500 response for a route whose handler derives its
+// response from the spec's example, if the example is missing or not shaped
+// as the handler expects.
+func missingExample(route apispec.Route) response {
+	return errorResponse(http.StatusInternalServerError, "The spec has no usable example response for "+route.OperationID)
+}
+
+// header returns the header of the block in slot, as it appears in responses.
+func (c *Chain) header(slot int) map[string]interface{} {
+	message := map[string]interface{}{
+		"slot":           strconv.Itoa(slot),
+		"proposer_index": strconv.Itoa(c.proposer(slot)),
+		"parent_root":    c.parentRoot(slot),
+		"state_root":     c.StateRoot(slot),
+		"body_root":      hash("body", slot, 32),
+	}
+	return map[string]interface{}{
+		"root":      c.BlockRoot(slot),
+		"canonical": true,
+		"header": map[string]interface{}{
+			"message":   message,
+			"signature": c.Sign(message),
+		},
+	}
+}
+
+// blockMessage fills in the fields of an example block message which describe
+// the block in slot, and returns it. Its attestations are those of the slot
+// before.
+func (c *Chain) blockMessage(slot int, message map[string]interface{}) map[string]interface{} {
+	message["slot"] = strconv.Itoa(slot)
+	message["proposer_index"] = strconv.Itoa(c.proposer(slot))
+	message["parent_root"] = c.parentRoot(slot)
+	message["state_root"] = c.StateRoot(slot)
+	if body, ok := message["body"].(map[string]interface{}); ok {
+		body["attestations"] = c.blockAttestations(slot)
+	}
+	return message
+}
+
+// parentRoot returns the root of the parent of the block in slot. The genesis
+// block has the zero root as its parent.
+func (c *Chain) parentRoot(slot int) string {
+	if slot == 0 {
+		return "0x" + strings.Repeat("0", 64)
+	}
+	return c.BlockRoot(slot - 1)
+}
+
+// blockAttestations returns the attestations included in the block in slot,
+// which are to the slot before.
+func (c *Chain) blockAttestations(slot int) []interface{} {
+	if slot == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{c.attestation(slot - 1)}
+}
+
+// attestation returns the aggregate attestation of the committee of slot.
+func (c *Chain) attestation(slot int) map[string]interface{} {
+	data := c.attestationData(slot)
+	return map[string]interface{}{
+		"aggregation_bits": "0x01",
+		"data":             data,
+		"signature":        c.Sign(data),
+	}
+}
+
+// attestationData returns the data attested to by the committee of slot.
+func (c *Chain) attestationData(slot int) map[string]interface{} {
+	_, justified, _ := c.checkpoints(slot)
+	return map[string]interface{}{
+		"slot":              strconv.Itoa(slot),
+		"index":             "0",
+		"beacon_block_root": c.BlockRoot(slot),
+		"source":            c.checkpoint(justified),
+		"target":            c.checkpoint(c.Epoch(slot)),
+	}
+}
+
+// validator returns the validator with index, as it appears in responses.
+func (c *Chain) validator(index int) map[string]interface{} {
+	return map[string]interface{}{
+		"index":   strconv.Itoa(index),
+		"balance": maxEffectiveBalance,
+		"status":  "active_ongoing",
+		"validator": map[string]interface{}{
+			"pubkey":                       c.Pubkey(index),
+			"withdrawal_credentials":       hash("withdrawal", index, 32),
+			"effective_balance":            maxEffectiveBalance,
+			"slashed":                      false,
+			"activation_eligibility_epoch": "0",
+			"activation_epoch":             "0",
+			"exit_epoch":                   farFutureEpoch,
+			"withdrawable_epoch":           farFutureEpoch,
+		},
+	}
+}
+
+// validatorIndex returns the index of the validator identified by a
+// validator_id, which is either an index or a public key.
+func (c *Chain) validatorIndex(id string) (int, bool) {
+	if strings.HasPrefix(id, "0x") {
+		for index := 0; index < c.Validators; index++ {
+			if c.Pubkey(index) == id {
+				return index, true
+			}
+		}
+		return 0, false
+	}
+
+	index, err := strconv.Atoi(id)
+	if err != nil || index < 0 || index >= c.Validators {
+		return 0, false
+	}
+	return index, true
+}
+
+// fork returns the chain's only fork.
+func fork() map[string]interface{} {
+	return map[string]interface{}{
+		"previous_version": "0x00000000",
+		"current_version":  "0x00000000",
+		"epoch":            "0",
+	}
+}
+
+// hasStatus reports whether a validator status is one of statuses, which may
+// also name groups of statuses such as "active".
+func hasStatus(status string, statuses []string) bool {
+	for _, s := range statuses {
+		if status == s || strings.HasPrefix(status, s+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+// values returns the values of a query param of req which is an array, given
+// either by repeating the param or as a comma-separated list.
+func values(req request, name string) []string {
+	result := []string{}
+	for _, value := range req.query[name] {
+		for _, item := range strings.Split(value, ",") {
+			if item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
+
+// decimals formats a list of indices as the decimal strings responses use.
+func decimals(indices []int) []interface{} {
+	result := make([]interface{}, len(indices))
+	for i, index := range indices {
+		result[i] = strconv.Itoa(index)
+	}
+	return result
+}
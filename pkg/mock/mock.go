@@ -0,0 +1,237 @@
+// package mock implements a beacon node serving every operation of the bundled
+// API specification, so that test suites and eth2-comply itself can be run
+// without a live client. Responses are read from a directory of fixtures, or
+// derived from a deterministic generated Chain whose head advances with the
+// wall clock.
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+)
+
+// Opts configures a Node. Zero values are replaced by the defaults described.
+type Opts struct {
+	// Fixtures is a directory of response bodies which are served in place
+	// of generated responses. See Node.
+	Fixtures string
+	// Genesis is the genesis time of the generated chain. Defaults to the
+	// time New is called.
+	Genesis time.Time
+	// SecondsPerSlot defaults to 12.
+	SecondsPerSlot int
+	// SlotsPerEpoch defaults to 32.
+	SlotsPerEpoch int
+	// Validators is the number of validators of the generated chain.
+	// Defaults to 64.
+	Validators int
+}
+
+// Node is an http.Handler serving the beacon node API. A request is answered
+// from the fixture for its method and path if there is one: the JSON file at
+// <Fixtures>/<method>/<path>.json, e.g. get/eth/v1/beacon/genesis.json,
+// holds the body of a 200 response, whatever the query params. Otherwise, the
+// response is derived from the Node's Chain, or is the example from the spec
+// for operations which do not depend on the chain. Requests with bodies are
+// validated against the spec, and answered with a 400 response if they are
+// malformed or carry a signature not made by Chain.Sign.
+//
+// A Node can also corrupt its responses to measure how strong a suite is. See
+// Mutate.
+type Node struct {
+	Chain    *Chain
+	fixtures string
+
+	mu       sync.Mutex
+	mutation *Mutation
+	served   map[string]servedResponse
+}
+
+// New returns a Node configured by opts.
+func New(opts Opts) (*Node, error) {
+	if opts.Genesis.IsZero() {
+		opts.Genesis = time.Now()
+	}
+	if opts.SecondsPerSlot == 0 {
+		opts.SecondsPerSlot = 12
+	}
+	if opts.SlotsPerEpoch == 0 {
+		opts.SlotsPerEpoch = 32
+	}
+	if opts.Validators == 0 {
+		opts.Validators = 64
+	}
+	if opts.SecondsPerSlot < 0 || opts.SlotsPerEpoch < 0 || opts.Validators < 0 {
+		return nil, fmt.Errorf("seconds per slot, slots per epoch and validators must not be negative")
+	}
+
+	if opts.Fixtures != "" {
+		info, err := os.Stat(opts.Fixtures)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", opts.Fixtures)
+		}
+	}
+
+	return &Node{
+		Chain: &Chain{
+			Genesis:        opts.Genesis,
+			SecondsPerSlot: opts.SecondsPerSlot,
+			SlotsPerEpoch:  opts.SlotsPerEpoch,
+			Validators:     opts.Validators,
+		},
+		fixtures: opts.Fixtures,
+		served:   map[string]servedResponse{},
+	}, nil
+}
+
+// request is a request to the Node, matched to the operation serving it.
+type request struct {
+	route  apispec.Route
+	params apispec.PathParams
+	query  url.Values
+	body   []byte
+}
+
+// response is the status code and JSON body, if any, of a response.
+type response struct {
+	status int
+	body   interface{}
+}
+
+func (n *Node) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route, params, ok := apispec.MatchRoute(r.Method, r.URL.Path)
+	if !ok {
+		writeResponse(w, errorResponse(http.StatusNotFound, fmt.Sprintf("%s %s is not an operation of the API", r.Method, r.URL.Path)))
+		return
+	}
+
+	if route.OperationID == "eventstream" {
+		n.serveEvents(w, r)
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeResponse(w, errorResponse(http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	writeResponse(w, n.intercept(route, n.respond(request{
+		route:  route,
+		params: params,
+		query:  r.URL.Query(),
+		body:   body,
+	})))
+}
+
+// respond returns the response to req.
+func (n *Node) respond(req request) response {
+	if fixture, ok, err := n.fixture(req); err != nil {
+		return errorResponse(http.StatusInternalServerError, err.Error())
+	} else if ok {
+		return response{status: http.StatusOK, body: fixture}
+	}
+
+	if schema, ok := req.route.RequestBody(); ok {
+		var body interface{}
+		if err := json.Unmarshal(req.body, &body); err != nil {
+			return errorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", err))
+		}
+		if violations := schema.Validate(body); len(violations) > 0 {
+			return errorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid request body: %s", apispec.SchemaError{Violations: violations}))
+		}
+		if pointer, ok := n.Chain.invalidSignature(body, ""); ok {
+			return errorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid signature at %q of the request body", pointer))
+		}
+	}
+
+	if handle, ok := handlers[req.route.OperationID]; ok {
+		return handle(n.Chain, req)
+	}
+	return exampleResponse(req.route)
+}
+
+// fixture returns the body of the fixture for req, if there is one.
+func (n *Node) fixture(req request) (interface{}, bool, error) {
+	if n.fixtures == "" {
+		return nil, false, nil
+	}
+
+	path := filepath.Join(n.fixtures, strings.ToLower(req.route.Method), filepath.FromSlash(fillPath(req.route.Path, req.params))+".json")
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var body interface{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return nil, false, fmt.Errorf("Fixture %s is not valid JSON: %s", path, err)
+	}
+	return body, true, nil
+}
+
+// fillPath replaces the params in a path template with their values.
+func fillPath(path string, params apispec.PathParams) string {
+	for name, value := range params {
+		path = strings.Replace(path, "{"+name+"}", value, 1)
+	}
+	return path
+}
+
+// exampleResponse returns the 200 response of route, with the example body
+// the spec describes, if any.
+func exampleResponse(route apispec.Route) response {
+	declared, ok := route.Response(http.StatusOK)
+	if !ok {
+		return response{status: http.StatusOK}
+	}
+	schema, ok := declared.Content["application/json"]
+	if !ok {
+		return response{status: http.StatusOK}
+	}
+	return response{status: http.StatusOK, body: schema.Example()}
+}
+
+// errorResponse returns a response with the error body of the spec.
+func errorResponse(status int, message string) response {
+	return response{
+		status: status,
+		body: map[string]interface{}{
+			"code":    status,
+			"message": message,
+		},
+	}
+}
+
+// writeResponse writes resp to w, as JSON if it has a body.
+func writeResponse(w http.ResponseWriter, resp response) {
+	if resp.body == nil {
+		w.WriteHeader(resp.status)
+		return
+	}
+
+	data, err := json.Marshal(resp.body)
+	if err != nil {
+		resp = errorResponse(http.StatusInternalServerError, err.Error())
+		data, _ = json.Marshal(resp.body)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.status)
+	w.Write(data)
+}
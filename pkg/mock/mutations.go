@@ -0,0 +1,237 @@
+package mock
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+)
+
+// MutationKind is a way of corrupting a response.
+type MutationKind string
+
+const (
+	// WrongType replaces a value with one of another type, e.g. a numeric
+	// string with a number.
+	WrongType MutationKind = "wrong type"
+	// MissingField removes a property of an object.
+	MissingField MutationKind = "missing field"
+	// WrongStatus serves another status code the spec declares for the
+	// operation.
+	WrongStatus MutationKind = "wrong status"
+	// SwappedOrder swaps the first two items of a list.
+	SwappedOrder MutationKind = "swapped order"
+	// OffByOneSlot adds one to a slot.
+	OffByOneSlot MutationKind = "off-by-one slot"
+)
+
+// Mutation corrupts the responses of one operation in one way.
+type Mutation struct {
+	Kind  MutationKind
+	Route apispec.Route
+	// Pointer is the JSON pointer of the corrupted value in the response
+	// body, e.g. "/data/0/slot". It is empty for WrongStatus mutations.
+	Pointer string
+	// Status is the status code served by WrongStatus mutations.
+	Status int
+}
+
+func (m Mutation) String() string {
+	if m.Kind == WrongStatus {
+		return fmt.Sprintf("%s %d", m.Kind, m.Status)
+	}
+	return fmt.Sprintf("%s at %s", m.Kind, m.Pointer)
+}
+
+// Mutate makes the Node corrupt every response to the operation of m as m
+// describes, until it is called again. A nil m stops the corruption.
+func (n *Node) Mutate(m *Mutation) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.mutation = m
+}
+
+// Mutations returns every mutation of the responses the Node has served so
+// far. The response first served for each operation with a 2xx status is used
+// to find the values which can be corrupted. Within lists, only the first
+// item's values are corrupted. Mutations are sorted by route and method.
+func (n *Node) Mutations() []Mutation {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	keys := make([]string, 0, len(n.served))
+	for key := range n.served {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := n.served[keys[i]].route, n.served[keys[j]].route
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Method < b.Method
+	})
+
+	result := []Mutation{}
+	for _, key := range keys {
+		served := n.served[key]
+		result = append(result, mutations(served.route, served.response)...)
+	}
+	return result
+}
+
+// servedResponse is a response the Node has served, and the operation it was
+// for.
+type servedResponse struct {
+	route apispec.Route
+	response
+}
+
+// intercept records resp as served for route, if it is the first 2xx response
+// to route, or corrupts it if the current mutation is of route.
+func (n *Node) intercept(route apispec.Route, resp response) response {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	key := route.Method + " " + route.Path
+	if n.mutation == nil {
+		if _, ok := n.served[key]; !ok && resp.status/100 == 2 {
+			n.served[key] = servedResponse{route: route, response: resp}
+		}
+		return resp
+	}
+
+	if m := n.mutation; m.Route.Method == route.Method && m.Route.Path == route.Path {
+		return m.apply(resp)
+	}
+	return resp
+}
+
+// apply returns resp corrupted by m. The body of resp is modified in place.
+func (m Mutation) apply(resp response) response {
+	if m.Kind == WrongStatus {
+		if m.Status >= 400 {
+			return errorResponse(m.Status, http.StatusText(m.Status))
+		}
+		return response{status: m.Status, body: resp.body}
+	}
+
+	tokens := strings.Split(strings.TrimPrefix(m.Pointer, "/"), "/")
+	parent := resp.body
+	for _, token := range tokens[:len(tokens)-1] {
+		var ok bool
+		if parent, ok = child(parent, token); !ok {
+			return resp
+		}
+	}
+	last := tokens[len(tokens)-1]
+	value, ok := child(parent, last)
+	if !ok {
+		return resp
+	}
+
+	if m.Kind == MissingField {
+		if object, ok := parent.(map[string]interface{}); ok {
+			delete(object, last)
+		}
+		return resp
+	}
+
+	switch m.Kind {
+	case WrongType:
+		value = wrongType(value)
+	case SwappedOrder:
+		if list, ok := value.([]interface{}); ok && len(list) >= 2 {
+			list[0], list[1] = list[1], list[0]
+		}
+	case OffByOneSlot:
+		if slot, err := strconv.ParseUint(fmt.Sprint(value), 10, 64); err == nil {
+			value = strconv.FormatUint(slot+1, 10)
+		}
+	}
+	switch parent := parent.(type) {
+	case map[string]interface{}:
+		parent[last] = value
+	case []interface{}:
+		index, _ := strconv.Atoi(last)
+		parent[index] = value
+	}
+	return resp
+}
+
+// mutations returns the mutations of resp, a response to route.
+func mutations(route apispec.Route, resp response) []Mutation {
+	result := []Mutation{}
+
+	for _, status := range route.Statuses() {
+		code, err := strconv.Atoi(status)
+		if err == nil && code != resp.status {
+			result = append(result, Mutation{Kind: WrongStatus, Route: route, Status: code})
+			break
+		}
+	}
+
+	var walk func(v interface{}, pointer string, key string)
+	walk = func(v interface{}, pointer string, key string) {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for _, name := range sortedKeys(v) {
+				childPointer := pointer + "/" + name
+				result = append(result, Mutation{Kind: MissingField, Route: route, Pointer: childPointer})
+				walk(v[name], childPointer, name)
+			}
+		case []interface{}:
+			if len(v) >= 2 && !reflect.DeepEqual(v[0], v[1]) {
+				result = append(result, Mutation{Kind: SwappedOrder, Route: route, Pointer: pointer})
+			}
+			if len(v) > 0 {
+				walk(v[0], pointer+"/0", "")
+			}
+		default:
+			result = append(result, Mutation{Kind: WrongType, Route: route, Pointer: pointer})
+			if _, err := strconv.ParseUint(fmt.Sprint(v), 10, 64); err == nil && (key == "slot" || strings.HasSuffix(key, "_slot")) {
+				result = append(result, Mutation{Kind: OffByOneSlot, Route: route, Pointer: pointer})
+			}
+		}
+	}
+	if resp.body != nil {
+		walk(resp.body, "", "")
+	}
+
+	return result
+}
+
+// child returns the property or item of v named by a JSON pointer token.
+func child(v interface{}, token string) (interface{}, bool) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		value, ok := v[token]
+		return value, ok
+	case []interface{}:
+		index, err := strconv.Atoi(token)
+		if err != nil || index < 0 || index >= len(v) {
+			return nil, false
+		}
+		return v[index], true
+	}
+	return nil, false
+}
+
+// wrongType returns a value like v, but of another JSON type. Numeric strings
+// become numbers, as a client serializing integers wrongly would serve them.
+func wrongType(v interface{}) interface{} {
+	switch v := v.(type) {
+	case string:
+		n, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return len(v)
+		}
+		return n
+	case nil:
+		return ""
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,201 @@
+// package mutation measures how strong a suite of test cases is. It runs the
+// suite against a mock beacon node which corrupts its responses one way at a
+// time, and reports the corruptions which no case caught.
+package mutation
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// Opts configures how cases are executed.
+type Opts struct {
+	// Concurrency is the maximum number of requests in flight at once.
+	// Values less than one mean one.
+	Concurrency int
+	// RequestTimeout bounds the execution of each case, unless the case
+	// sets its own timeout. Zero means cases are only bounded by the
+	// context given to Run.
+	RequestTimeout time.Duration
+}
+
+// RouteReport describes the mutations of the responses of one operation.
+type RouteReport struct {
+	// Method and Route identify the operation, with Route being the path
+	// template from the spec, e.g. "/eth/v1/beacon/headers/{block_id}".
+	Method string
+	Route  string
+	// Cases is the number of cases which make requests to the operation.
+	Cases int
+	// Mutations is the number of mutations tried.
+	Mutations int
+	// Survived are the mutations which no case caught.
+	Survived []mock.Mutation
+}
+
+// Killed returns the number of mutations which a case caught.
+func (r RouteReport) Killed() int {
+	return r.Mutations - len(r.Survived)
+}
+
+// Report is the outcome of a mutation run.
+type Report struct {
+	// Broken are the cases which failed against the uncorrupted node. They
+	// are not used to catch mutations.
+	Broken []*testcases.Case
+	// Routes holds a RouteReport for each operation the node served,
+	// sorted by route and then method.
+	Routes []RouteReport
+	// Mutations is the number of mutations tried, and Killed the number of
+	// them which a case caught.
+	Mutations int
+	Killed    int
+}
+
+// Score returns the fraction of mutations which a case caught.
+func (r Report) Score() float64 {
+	if r.Mutations == 0 {
+		return 0
+	}
+	return float64(r.Killed) / float64(r.Mutations)
+}
+
+// Run executes cases against node, which must be served at the target in ctx.
+// It first executes every case against the uncorrupted node, to find which
+// responses can be corrupted. Then, for each mutation of those responses, it
+// executes the cases which make requests to the mutated operation again. The
+// mutation is caught if any of them fails.
+//
+// Cases are executed without waiting for their await slots or conditions, so
+// the head of node's chain should already be past every await slot. Event
+// stream cases are skipped, as event streams are not corrupted.
+func Run(ctx context.Context, node *mock.Node, cases []*testcases.Case, opts Opts) Report {
+	if opts.Concurrency < 1 {
+		opts.Concurrency = 1
+	}
+
+	usable := []*testcases.Case{}
+	for _, c := range cases {
+		if c.Config.Events == nil {
+			usable = append(usable, c)
+		}
+	}
+
+	report := Report{Broken: []*testcases.Case{}}
+	node.Mutate(nil)
+	passing := []*testcases.Case{}
+	for i, result := range execute(ctx, usable, opts) {
+		if result.Result.Success {
+			passing = append(passing, usable[i])
+		} else {
+			report.Broken = append(report.Broken, result)
+		}
+	}
+
+	byOperation := map[string][]*testcases.Case{}
+	for _, c := range passing {
+		for _, key := range operations(c) {
+			byOperation[key] = append(byOperation[key], c)
+		}
+	}
+
+	routes := map[string]*RouteReport{}
+	order := []string{}
+	for _, m := range node.Mutations() {
+		if ctx.Err() != nil {
+			break
+		}
+
+		key := m.Route.Method + " " + m.Route.Path
+		route, ok := routes[key]
+		if !ok {
+			route = &RouteReport{
+				Method:   m.Route.Method,
+				Route:    m.Route.Path,
+				Cases:    len(byOperation[key]),
+				Survived: []mock.Mutation{},
+			}
+			routes[key] = route
+			order = append(order, key)
+		}
+
+		route.Mutations++
+		if !caught(ctx, node, m, byOperation[key], opts) {
+			route.Survived = append(route.Survived, m)
+		}
+	}
+
+	for _, key := range order {
+		route := routes[key]
+		report.Routes = append(report.Routes, *route)
+		report.Mutations += route.Mutations
+		report.Killed += route.Killed()
+	}
+	return report
+}
+
+// caught reports whether any of cases fails while node is corrupted by m.
+func caught(ctx context.Context, node *mock.Node, m mock.Mutation, cases []*testcases.Case, opts Opts) bool {
+	if len(cases) == 0 {
+		return false
+	}
+
+	node.Mutate(&m)
+	defer node.Mutate(nil)
+
+	for _, result := range execute(ctx, cases, opts) {
+		if !result.Result.Success {
+			return true
+		}
+	}
+	return false
+}
+
+// execute executes fresh copies of cases, without their await slots and
+// conditions, and returns the copies once they are done.
+func execute(ctx context.Context, cases []*testcases.Case, opts Opts) []*testcases.Case {
+	copies := make([]*testcases.Case, len(cases))
+	for i, c := range cases {
+		config := c.Config
+		config.AwaitSlot = 0
+		config.Await = nil
+		copies[i] = testcases.NewCase(config)
+		copies[i].RequestTimeout = opts.RequestTimeout
+	}
+
+	testcases.ExecAll(ctx, copies, "/", opts.Concurrency)
+	for _, c := range copies {
+		<-c.Done
+	}
+	return copies
+}
+
+// operations returns the keys, "<method> <path template>", of the operations
+// a case makes requests to.
+func operations(c *testcases.Case) []string {
+	configs := []testcases.CaseConfig{c.Config}
+	for _, step := range c.Config.Steps {
+		configs = append(configs, step.CaseConfig)
+	}
+
+	keys := []string{}
+	for _, config := range configs {
+		if config.Route == "" {
+			continue
+		}
+		route, err := url.Parse(config.Route)
+		if err != nil {
+			continue
+		}
+		specRoute, _, ok := apispec.MatchRoute(config.Method, route.Path)
+		if ok {
+			keys = append(keys, specRoute.Method+" "+specRoute.Path)
+		}
+	}
+	return keys
+}
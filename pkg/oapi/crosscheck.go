@@ -0,0 +1,101 @@
+package oapi
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/INFURA/eth2-comply/pkg/eth2spec"
+)
+
+type EventMismatchError struct {
+	Route string
+	Field string
+	Event string
+	REST  string
+}
+
+func (e EventMismatchError) Error() string {
+	return fmt.Sprintf("Event disagrees with %s on %s.\nEvent: %s\nREST API: %s", e.Route, e.Field, e.Event, e.REST)
+}
+
+// crossCheckEvent checks the data of an event against the state the target
+// serves on its REST API for the same objects. Events for topics with nothing
+// to cross-check against pass.
+func crossCheckEvent(ctx context.Context, topic string, data interface{}) error {
+	fields, _ := data.(map[string]interface{})
+	str := func(key string) string {
+		value, _ := fields[key].(string)
+		return value
+	}
+
+	switch topic {
+	case "head", "block":
+		return crossCheckBlock(ctx, str("block"), str("slot"))
+	case "finalized_checkpoint":
+		return crossCheckFinalizedCheckpoint(ctx, str("block"), str("epoch"))
+	}
+
+	return nil
+}
+
+// crossCheckBlock checks that the target serves the header and root of a block
+// it announced in an event.
+func crossCheckBlock(ctx context.Context, root, slot string) error {
+	headerRoute := fmt.Sprintf("/eth/v1/beacon/headers/%s", root)
+	result, err := ExecGetBeaconHeader(ctx, root)
+	if err != nil {
+		return EventMismatchError{Route: headerRoute, Field: "block", Event: root, REST: err.Error()}
+	}
+	header := result.Response.(eth2spec.GetBlockHeaderResponse)
+	if header.Data.Root != root {
+		return EventMismatchError{Route: headerRoute, Field: "block", Event: root, REST: header.Data.Root}
+	}
+	headerSlot := fmt.Sprint(header.Data.Header.Message["slot"])
+	if headerSlot != slot {
+		return EventMismatchError{Route: headerRoute, Field: "slot", Event: slot, REST: headerSlot}
+	}
+
+	rootRoute := fmt.Sprintf("/eth/v1/beacon/blocks/%s/root", root)
+	result, err = ExecGetBeaconBlockRoot(ctx, root)
+	if err != nil {
+		return EventMismatchError{Route: rootRoute, Field: "block", Event: root, REST: err.Error()}
+	}
+	blockRoot := fmt.Sprint(result.Response.(eth2spec.GetBlockRootResponse).Data.Root)
+	if blockRoot != root {
+		return EventMismatchError{Route: rootRoute, Field: "block", Event: root, REST: blockRoot}
+	}
+
+	return nil
+}
+
+// crossCheckFinalizedCheckpoint checks that a finalized checkpoint announced in
+// an event is the target's finalized checkpoint at its head. The chain may
+// finalize again between the event and the request, so a newer finalized
+// epoch at the head is not a mismatch.
+func crossCheckFinalizedCheckpoint(ctx context.Context, root, epoch string) error {
+	route := "/eth/v1/beacon/states/head/finality_checkpoints"
+	result, err := ExecGetBeaconStatesFinalityCheckpoints(ctx, "head")
+	if err != nil {
+		return EventMismatchError{Route: route, Field: "epoch", Event: epoch, REST: err.Error()}
+	}
+	finalized := result.Response.(eth2spec.GetStateFinalityCheckpointsResponse).Data.Finalized
+
+	eventEpoch, err := strconv.ParseUint(epoch, 10, 64)
+	if err != nil {
+		return err
+	}
+	restEpoch, err := strconv.ParseUint(finalized.Epoch, 10, 64)
+	if err != nil {
+		return EventMismatchError{Route: route, Field: "epoch", Event: epoch, REST: finalized.Epoch}
+	}
+
+	switch {
+	case restEpoch < eventEpoch:
+		return EventMismatchError{Route: route, Field: "epoch", Event: epoch, REST: finalized.Epoch}
+	case restEpoch == eventEpoch && finalized.Root != root:
+		return EventMismatchError{Route: route, Field: "root", Event: root, REST: finalized.Root}
+	}
+
+	return nil
+}
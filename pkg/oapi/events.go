@@ -0,0 +1,320 @@
+package oapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+)
+
+const eventsPath = "/eth/v1/events"
+
+// Event is a single Server-Sent Event received from the target.
+type Event struct {
+	// Topic is the value of the event's event field, e.g. "head".
+	Topic string
+	// Data is the event's data field, with the lines of multi-line data
+	// joined by newlines.
+	Data []byte
+}
+
+// EventStream is an open subscription to the target's event stream. Events are
+// read from it with Next, and it must be closed with Close.
+type EventStream struct {
+	httpdata *http.Response
+	scanner  *bufio.Scanner
+}
+
+// Subscribe opens the target's event stream for the given topics. The stream is
+// closed when ctx is done.
+//
+// The generated client cannot be used for this: it reads the whole response
+// body before returning, which never happens for an open stream.
+func Subscribe(ctx context.Context, topics []string) (*EventStream, error) {
+	cfg := GetClient(ctx).GetConfig()
+
+	query := url.Values{}
+	for _, topic := range topics {
+		query.Add("topics", topic)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, cfg.BasePath+eventsPath+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	for header, value := range cfg.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Cache-Control", "no-cache")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+
+	httpdata, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &EventStream{
+		httpdata: httpdata,
+		scanner:  bufio.NewScanner(httpdata.Body),
+	}
+	// Events such as attestations are larger than the scanner's default
+	// 64 KiB line limit allows for comfortably.
+	stream.scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+
+	return stream, nil
+}
+
+// Response returns the HTTP response the stream is read from. Its body must
+// not be read directly.
+func (s *EventStream) Response() *http.Response {
+	return s.httpdata
+}
+
+// Next blocks until the next event is received and returns it. Comments and
+// fields other than event and data are ignored, as are events without data.
+// Next returns io.EOF when the target closes the stream.
+func (s *EventStream) Next() (Event, error) {
+	event := Event{}
+	data := []string{}
+
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+
+		// A blank line dispatches the event.
+		if line == "" {
+			if len(data) == 0 {
+				event = Event{}
+				continue
+			}
+			if event.Topic == "" {
+				event.Topic = "message"
+			}
+			event.Data = []byte(strings.Join(data, "\n"))
+			return event, nil
+		}
+
+		// Lines starting with a colon are comments, often used as keep-alives.
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		field, value := line, ""
+		if i := strings.Index(line, ":"); i >= 0 {
+			field, value = line[:i], strings.TrimPrefix(line[i+1:], " ")
+		}
+		switch field {
+		case "event":
+			event.Topic = value
+		case "data":
+			data = append(data, value)
+		}
+	}
+
+	if err := s.scanner.Err(); err != nil {
+		return Event{}, err
+	}
+	return Event{}, io.EOF
+}
+
+// Close closes the stream.
+func (s *EventStream) Close() error {
+	return s.httpdata.Body.Close()
+}
+
+type EventError struct {
+	Index int
+	Topic string
+	Err   error
+}
+
+func (e EventError) Error() string {
+	return fmt.Sprintf("Event %d (%s) is invalid: %s", e.Index, e.Topic, e.Err.Error())
+}
+
+type UnexpectedTopicError struct {
+	Topic  string
+	Topics []string
+}
+
+func (e UnexpectedTopicError) Error() string {
+	return fmt.Sprintf("Received an event with topic %q, but only subscribed to: %s", e.Topic, strings.Join(e.Topics, ", "))
+}
+
+type MissingEventsError struct {
+	Expected int
+	// Duration is how long the stream was to be listened to, when any
+	// number of events was acceptable.
+	Duration time.Duration
+	Received int
+	Err      error
+}
+
+func (e MissingEventsError) Error() string {
+	if e.Expected == 0 {
+		return fmt.Sprintf("Expected to listen to the stream for %s, but it ended after %d events: %s", e.Duration, e.Received, e.Err.Error())
+	}
+	return fmt.Sprintf("Expected at least %d events, received %d before the stream ended: %s", e.Expected, e.Received, e.Err.Error())
+}
+
+// ExecGetEventsOpts configures ExecGetEvents.
+type ExecGetEventsOpts struct {
+	Topics []string
+	// Count is the number of events to wait for. If Duration is also set,
+	// the events must be received within it.
+	Count int
+	// Duration is how long to listen to the stream for. If Count is not set,
+	// any number of events received in that time is acceptable.
+	Duration time.Duration
+	// Strict enables strict validation of the data of each event.
+	Strict bool
+	// CrossCheck enables checking head, block and finalized_checkpoint
+	// events against the target's REST API as they are received.
+	CrossCheck bool
+}
+
+// ExecGetEvents subscribes to the target's event stream and collects events
+// until Count events are received or Duration elapses. The data of every event
+// is validated against the schema for its topic. If neither Count nor Duration
+// is set, it waits for a single event.
+//
+// The Response of the result is a list of the events received, each an object
+// with the event's topic and its data decoded into generic JSON types.
+func ExecGetEvents(ctx context.Context, opts ExecGetEventsOpts) (*ExecutorResult, error) {
+	if opts.Count == 0 && opts.Duration == 0 {
+		opts.Count = 1
+	}
+
+	route, _, _ := apispec.MatchRoute(http.MethodGet, eventsPath)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if opts.Duration > 0 {
+		streamCtx, cancel = context.WithTimeout(streamCtx, opts.Duration)
+		defer cancel()
+	}
+
+	stream, err := Subscribe(streamCtx, opts.Topics)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+	httpdata := stream.Response()
+
+	result := &ExecutorResult{
+		Response:   nil,
+		ResponseDS: nil,
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+	}
+
+	if httpdata.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(httpdata.Body)
+		result.Body = body
+		if err := ValidateResponse(route, result, opts.Strict); err != nil {
+			return nil, err
+		}
+		if len(body) > 0 && isJSON(httpdata.Header.Get("Content-Type")) {
+			if err := json.Unmarshal(body, &result.Response); err != nil {
+				return nil, err
+			}
+		}
+		return result, ResponseError{Status: httpdata.Status, body: body}
+	}
+
+	if err := ValidateResponse(route, result, opts.Strict); err != nil {
+		return nil, err
+	}
+
+	events, err := collectEvents(ctx, streamCtx, stream, opts)
+	result.Response = events
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// collectEvents reads and validates events from stream, whose context is
+// streamCtx, until enough have been received, or until the stream ends. REST
+// requests made to cross-check events use ctx rather than streamCtx, so that
+// they are not cut short when the stream's duration elapses.
+func collectEvents(ctx, streamCtx context.Context, stream *EventStream, opts ExecGetEventsOpts) ([]interface{}, error) {
+	events := []interface{}{}
+
+	for opts.Count == 0 || len(events) < opts.Count {
+		event, err := stream.Next()
+		if err != nil {
+			// Listening for a duration, rather than for a number of events,
+			// ends when the stream is closed by its own deadline. Any other
+			// end, like ctx being cancelled or the connection dropping, is a
+			// failure.
+			if opts.Count == 0 && streamCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+				return events, nil
+			}
+			return events, MissingEventsError{Expected: opts.Count, Duration: opts.Duration, Received: len(events), Err: err}
+		}
+
+		data, err := validateEvent(event, opts)
+		if err != nil {
+			return events, EventError{Index: len(events), Topic: event.Topic, Err: err}
+		}
+
+		if opts.CrossCheck {
+			if err := crossCheckEvent(ctx, event.Topic, data); err != nil {
+				return events, EventError{Index: len(events), Topic: event.Topic, Err: err}
+			}
+		}
+
+		events = append(events, map[string]interface{}{
+			"event": event.Topic,
+			"data":  data,
+		})
+	}
+
+	return events, nil
+}
+
+// validateEvent checks that an event has a subscribed topic and that its data
+// satisfies the schema for that topic, and returns the decoded data.
+func validateEvent(event Event, opts ExecGetEventsOpts) (interface{}, error) {
+	subscribed := false
+	for _, topic := range opts.Topics {
+		if topic == event.Topic {
+			subscribed = true
+		}
+	}
+	if !subscribed {
+		return nil, UnexpectedTopicError{Topic: event.Topic, Topics: opts.Topics}
+	}
+
+	var data interface{}
+	if err := json.Unmarshal(event.Data, &data); err != nil {
+		return nil, err
+	}
+
+	schema, ok := apispec.EventSchema(event.Topic)
+	if !ok {
+		return data, nil
+	}
+	var violations []apispec.Violation
+	if opts.Strict {
+		violations = schema.ValidateStrict(data)
+	} else {
+		violations = schema.Validate(data)
+	}
+	if len(violations) > 0 {
+		return nil, apispec.SchemaError{Violations: violations}
+	}
+
+	return data, nil
+}
@@ -0,0 +1,91 @@
+package oapi
+
+import (
+	"context"
+	"mime"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	requestLimitKey key = 2
+	allowanceKey    key = 3
+)
+
+// WithRequestLimit returns a copy of ctx in which at most limit requests made
+// by clients created with WithClient are in flight at once. A request is in
+// flight until its response body has been read or closed, except for event
+// streams, which only count until they are established. If limit is less than
+// one, ctx is returned unchanged.
+func WithRequestLimit(ctx context.Context, limit int) context.Context {
+	if limit < 1 {
+		return ctx
+	}
+	return context.WithValue(ctx, requestLimitKey, make(chan struct{}, limit))
+}
+
+// allowance is a place among the requests in flight, taken ahead of a request
+// by AcquireRequest.
+type allowance struct {
+	claimed int32
+	once    sync.Once
+	release func()
+}
+
+// Release gives up the place, unless it has been given up already.
+func (a *allowance) Release() {
+	a.once.Do(a.release)
+}
+
+// AcquireRequest waits until the request limit in ctx, if any, allows another
+// request in flight, and returns a copy of ctx whose next request takes that
+// place rather than waiting for its own. The place is given up once that
+// request is done, or when the returned function is called, whichever is
+// first. It returns an error if ctx is done first.
+func AcquireRequest(ctx context.Context) (context.Context, func(), error) {
+	release, err := waitForPlace(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	a := &allowance{release: release}
+	return context.WithValue(ctx, allowanceKey, a), a.Release, nil
+}
+
+// acquireRequest returns the function giving up the place a request made with
+// ctx takes among the requests in flight. The place is the one AcquireRequest
+// took for ctx, if it has not been taken by an earlier request, or else one
+// waited for.
+func acquireRequest(ctx context.Context) (func(), error) {
+	if a, ok := ctx.Value(allowanceKey).(*allowance); ok && atomic.CompareAndSwapInt32(&a.claimed, 0, 1) {
+		return a.Release, nil
+	}
+	release, err := waitForPlace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var once sync.Once
+	return func() { once.Do(release) }, nil
+}
+
+// waitForPlace waits until the request limit in ctx, if any, allows another
+// request in flight, and returns the function giving up the place.
+func waitForPlace(ctx context.Context) (func(), error) {
+	limit, ok := ctx.Value(requestLimitKey).(chan struct{})
+	if !ok {
+		return func() {}, nil
+	}
+
+	select {
+	case limit <- struct{}{}:
+		return func() { <-limit }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isEventStream reports whether resp is a stream of server-sent events.
+func isEventStream(resp *http.Response) bool {
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	return mediaType == "text/event-stream"
+}
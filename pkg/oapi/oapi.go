@@ -3,8 +3,11 @@ package oapi
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/INFURA/eth2-comply/pkg/eth2spec"
 	"github.com/antihax/optional"
@@ -15,11 +18,17 @@ type key int
 const clientKey key = 0
 
 // WithClient returns a context with an OAPI client (accessible using
-// GetClient) which can conduct operations against the provided target.
+// GetClient) which can conduct operations against the provided target. Any
+// path in the target URL is kept as a base-path prefix for all routes.
 func WithClient(ctx context.Context, target url.URL) context.Context {
 	cfg := eth2spec.NewConfiguration()
 
-	cfg.BasePath = "http://" + target.Host
+	scheme := target.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	cfg.BasePath = scheme + "://" + target.Host + strings.TrimSuffix(target.Path, "/")
+	cfg.HTTPClient = &http.Client{Transport: recordingTransport{base: http.DefaultTransport}}
 
 	client := eth2spec.NewAPIClient(cfg)
 
@@ -53,6 +62,15 @@ type ExecutorResult struct {
 	// by the function. It can be used to compare against expected status
 	// code results.
 	StatusCode *int
+	// Header holds the headers of the HTTP response.
+	Header http.Header
+	// Body is the raw HTTP response body, exactly as it was received. Unlike
+	// Response, it can be validated strictly against the spec.
+	Body []byte
+	// Latency is the time from sending the request to reading the last of
+	// the response body, excluding decoding, if the request was made with a
+	// context from WithRecording.
+	Latency time.Duration
 }
 
 func ExecGetBeaconGenesis(ctx context.Context) (*ExecutorResult, error) {
@@ -66,6 +84,8 @@ func ExecGetBeaconGenesis(ctx context.Context) (*ExecutorResult, error) {
 		Response:   genesis,
 		ResponseDS: eth2spec.GetGenesisResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -82,6 +102,8 @@ func ExecGetBeaconStatesFork(ctx context.Context, stateId string) (*ExecutorResu
 		Response:   fork,
 		ResponseDS: eth2spec.GetStateForkResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -98,6 +120,8 @@ func ExecGetBeaconStatesRoot(ctx context.Context, stateId string) (*ExecutorResu
 		Response:   root,
 		ResponseDS: eth2spec.GetStateRootResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -114,6 +138,8 @@ func ExecGetBeaconStatesFinalityCheckpoints(ctx context.Context, stateId string)
 		Response:   finalityCheckpoint,
 		ResponseDS: eth2spec.GetStateFinalityCheckpointsResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -146,6 +172,8 @@ func ExecGetBeaconStatesCommittees(ctx context.Context, opts *ExecGetBeaconState
 		Response:   committees,
 		ResponseDS: eth2spec.GetEpochCommitteesResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -171,6 +199,8 @@ func ExecGetBeaconStatesValidators(ctx context.Context, stateId string, queryPar
 		Response:   validators,
 		ResponseDS: eth2spec.GetStateValidatorsResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -187,6 +217,8 @@ func ExecGetBeaconStatesValidator(ctx context.Context, stateId, validatorId stri
 		Response:   validator,
 		ResponseDS: eth2spec.GetStateValidatorResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -212,6 +244,8 @@ func ExecGetBeaconHeaders(ctx context.Context, queryParams map[string]string) (*
 		Response:   headers,
 		ResponseDS: eth2spec.GetBlockHeadersResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -228,6 +262,8 @@ func ExecGetBeaconHeader(ctx context.Context, blockId string) (*ExecutorResult,
 		Response:   header,
 		ResponseDS: eth2spec.GetBlockHeaderResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -244,6 +280,8 @@ func ExecGetBeaconBlock(ctx context.Context, blockId string) (*ExecutorResult, e
 		Response:   block,
 		ResponseDS: eth2spec.GetBlockResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -260,6 +298,8 @@ func ExecGetBeaconBlockRoot(ctx context.Context, blockId string) (*ExecutorResul
 		Response:   blockRoot,
 		ResponseDS: eth2spec.GetBlockRootResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -276,6 +316,91 @@ func ExecGetBeaconBlockAttestations(ctx context.Context, blockId string) (*Execu
 		Response:   blockAttestations,
 		ResponseDS: eth2spec.GetBlockAttestationsResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
+	}
+
+	return result, nil
+}
+
+func ExecGetBeaconPoolAttestations(ctx context.Context, queryParams map[string]string) (*ExecutorResult, error) {
+	getPoolAttestationsOpts := &eth2spec.GetPoolAttestationsOpts{}
+
+	if len(queryParams["slot"]) > 0 {
+		getPoolAttestationsOpts.Slot = optional.NewString(queryParams["slot"])
+	}
+	if len(queryParams["committee_index"]) > 0 {
+		getPoolAttestationsOpts.CommitteeIndex = optional.NewString(queryParams["committee_index"])
+	}
+
+	client := GetClient(ctx)
+	attestations, httpdata, err := client.BeaconApi.GetPoolAttestations(ctx, getPoolAttestationsOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   attestations,
+		ResponseDS: eth2spec.GetBlockAttestationsResponse{},
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
+	}
+
+	return result, nil
+}
+
+func ExecGetBeaconPoolAttesterSlashings(ctx context.Context) (*ExecutorResult, error) {
+	// The generated BeaconApi.GetPoolAttesterSlashings requests the
+	// misspelled "/atttester_slashings" path from the bundled spec.
+	slashings := eth2spec.GetPoolAttesterSlashingsResponse{}
+	httpdata, err := getJSON(ctx, "/eth/v1/beacon/pool/attester_slashings", nil, &slashings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   slashings,
+		ResponseDS: eth2spec.GetPoolAttesterSlashingsResponse{},
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
+	}
+
+	return result, nil
+}
+
+func ExecGetBeaconPoolProposerSlashings(ctx context.Context) (*ExecutorResult, error) {
+	client := GetClient(ctx)
+	slashings, httpdata, err := client.BeaconApi.GetPoolProposerSlashings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   slashings,
+		ResponseDS: eth2spec.GetPoolProposerSlashingsResponse{},
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
+	}
+
+	return result, nil
+}
+
+func ExecGetBeaconPoolVoluntaryExits(ctx context.Context) (*ExecutorResult, error) {
+	client := GetClient(ctx)
+	exits, httpdata, err := client.BeaconApi.GetPoolVoluntaryExits(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   exits,
+		ResponseDS: eth2spec.GetPoolVoluntaryExitsResponse{},
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -292,6 +417,8 @@ func ExecGetNodeHealth(ctx context.Context) (*ExecutorResult, error) {
 		Response:   nil,
 		ResponseDS: nil,
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -308,6 +435,8 @@ func ExecGetNodeSyncing(ctx context.Context) (*ExecutorResult, error) {
 		Response:   syncing,
 		ResponseDS: eth2spec.GetSyncingStatusResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -324,6 +453,8 @@ func ExecGetNodeVersion(ctx context.Context) (*ExecutorResult, error) {
 		Response:   version,
 		ResponseDS: eth2spec.GetVersionResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -340,6 +471,8 @@ func ExecGetNodeIdentity(ctx context.Context) (*ExecutorResult, error) {
 		Response:   identity,
 		ResponseDS: eth2spec.GetNetworkIdentityResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -356,6 +489,8 @@ func ExecGetNodePeers(ctx context.Context) (*ExecutorResult, error) {
 		Response:   peers,
 		ResponseDS: eth2spec.GetPeersResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -372,6 +507,8 @@ func ExecGetNodePeer(ctx context.Context, peerId string) (*ExecutorResult, error
 		Response:   peer,
 		ResponseDS: eth2spec.GetPeerResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -388,6 +525,8 @@ func ExecGetDebugBeaconHeads(ctx context.Context) (*ExecutorResult, error) {
 		Response:   heads,
 		ResponseDS: eth2spec.GetDebugChainHeadsResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -405,6 +544,8 @@ func ExecGetDebugBeaconStates(ctx context.Context, stateId string) (*ExecutorRes
 		Response:   states,
 		ResponseDS: eth2spec.GetStateResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -421,6 +562,8 @@ func ExecGetConfigForkSchedule(ctx context.Context) (*ExecutorResult, error) {
 		Response:   schedule,
 		ResponseDS: eth2spec.GetForkScheduleResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -437,6 +580,8 @@ func ExecGetConfigSpec(ctx context.Context) (*ExecutorResult, error) {
 		Response:   spec,
 		ResponseDS: eth2spec.GetSpecResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -453,6 +598,8 @@ func ExecGetConfigDepositContract(ctx context.Context) (*ExecutorResult, error)
 		Response:   DepositContract,
 		ResponseDS: eth2spec.GetDepositContractResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -481,6 +628,8 @@ func ExecGetValidatorDutiesAttester(
 		Response:   attester,
 		ResponseDS: eth2spec.GetAttesterDutiesResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -497,6 +646,8 @@ func ExecGetValidatorDutiesProposer(ctx context.Context, epoch string) (*Executo
 		Response:   proposer,
 		ResponseDS: eth2spec.GetProposerDutiesResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -529,6 +680,8 @@ func ExecGetValidatorBlocks(
 		Response:   block,
 		ResponseDS: eth2spec.ProduceBlockResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -556,6 +709,8 @@ func ExecGetValidatorAttestationData(
 		Response:   data,
 		ResponseDS: eth2spec.ProduceAttestationDataResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
@@ -583,7 +738,150 @@ func ExecGetValidatorAggregateAttestation(
 		Response:   aggregate,
 		ResponseDS: eth2spec.GetAggregatedAttestationResponse{},
 		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
 	}
 
 	return result, nil
 }
+
+// decodeReqBody converts a user-specified request body, which Go only knows as
+// an `interface{}`, into the OAPI data structure pointed to by ds.
+func decodeReqBody(reqBody interface{}, ds interface{}) error {
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, ds)
+}
+
+// postResult builds the ExecutorResult for operations that do not return a
+// response body on success. If the server responded with an error status, the
+// result is returned alongside the error so that callers can still evaluate
+// the status code against the Case's expectations.
+func postResult(httpdata *http.Response, err error) (*ExecutorResult, error) {
+	if httpdata == nil {
+		return nil, err
+	}
+	if err != nil && httpdata.StatusCode < 300 {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   nil,
+		ResponseDS: nil,
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       RawBody(httpdata),
+	}
+
+	// Error responses carry a body that can be compared against an expected
+	// response body like any other response.
+	if bodyErr, ok := err.(interface{ Body() []byte }); ok {
+		if httpdata.StatusCode == 400 {
+			model := eth2spec.InlineResponse400{}
+			if json.Unmarshal(bodyErr.Body(), &model) == nil {
+				result.Response = model
+				result.ResponseDS = eth2spec.InlineResponse400{}
+			}
+		} else {
+			model := eth2spec.InlineResponse500{}
+			if json.Unmarshal(bodyErr.Body(), &model) == nil {
+				result.Response = model
+				result.ResponseDS = eth2spec.InlineResponse500{}
+			}
+		}
+	}
+
+	return result, err
+}
+
+func ExecPostBeaconBlocks(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	block := eth2spec.SignedBeaconBlock{}
+	if err := decodeReqBody(reqBody, &block); err != nil {
+		return nil, err
+	}
+
+	// The bundled spec names both the publishBlock request body and the
+	// committee subscription object "inline_object", so the generated
+	// BeaconApi.PublishBlock takes a committee subscription and cannot carry
+	// a block.
+	httpdata, err := postJSON(ctx, "/eth/v1/beacon/blocks", block)
+	return postResult(httpdata, err)
+}
+
+func ExecPostBeaconPoolAttestations(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	attestation := eth2spec.InlineObject1{}
+	if err := decodeReqBody(reqBody, &attestation); err != nil {
+		return nil, err
+	}
+
+	client := GetClient(ctx)
+	httpdata, err := client.BeaconApi.SubmitPoolAttestations(ctx, attestation)
+	return postResult(httpdata, err)
+}
+
+func ExecPostBeaconPoolAttesterSlashings(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	slashing := eth2spec.InlineObject2{}
+	if err := decodeReqBody(reqBody, &slashing); err != nil {
+		return nil, err
+	}
+
+	// The generated BeaconApi.SubmitPoolAttesterSlashings posts to the
+	// misspelled "/atttester_slashings" path from the bundled spec.
+	httpdata, err := postJSON(ctx, "/eth/v1/beacon/pool/attester_slashings", slashing)
+	return postResult(httpdata, err)
+}
+
+func ExecPostBeaconPoolProposerSlashings(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	slashing := eth2spec.InlineObject3{}
+	if err := decodeReqBody(reqBody, &slashing); err != nil {
+		return nil, err
+	}
+
+	client := GetClient(ctx)
+	httpdata, err := client.BeaconApi.SubmitPoolProposerSlashings(ctx, slashing)
+	return postResult(httpdata, err)
+}
+
+func ExecPostBeaconPoolVoluntaryExits(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	exit := eth2spec.InlineObject4{}
+	if err := decodeReqBody(reqBody, &exit); err != nil {
+		return nil, err
+	}
+
+	client := GetClient(ctx)
+	httpdata, err := client.BeaconApi.SubmitPoolVoluntaryExit(ctx, exit)
+	return postResult(httpdata, err)
+}
+
+func ExecPostValidatorAggregateAndProofs(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	aggregates := eth2spec.InlineObject5{}
+	if err := decodeReqBody(reqBody, &aggregates); err != nil {
+		return nil, err
+	}
+
+	publishAggregateAndProofOpts := &eth2spec.PublishAggregateAndProofOpts{
+		InlineObject5: optional.NewInterface(aggregates),
+	}
+
+	client := GetClient(ctx)
+	httpdata, err := client.ValidatorApi.PublishAggregateAndProof(ctx, publishAggregateAndProofOpts)
+	return postResult(httpdata, err)
+}
+
+func ExecPostValidatorBeaconCommitteeSubscriptions(ctx context.Context, reqBody interface{}) (*ExecutorResult, error) {
+	subscriptions := []eth2spec.InlineObject{}
+	if err := decodeReqBody(reqBody, &subscriptions); err != nil {
+		return nil, err
+	}
+
+	prepareBeaconCommitteeSubnetOpts := &eth2spec.PrepareBeaconCommitteeSubnetOpts{
+		InlineObject: optional.NewInterface(subscriptions),
+	}
+
+	client := GetClient(ctx)
+	httpdata, err := client.ValidatorApi.PrepareBeaconCommitteeSubnet(ctx, prepareBeaconCommitteeSubnetOpts)
+	return postResult(httpdata, err)
+}
@@ -0,0 +1,266 @@
+package oapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+)
+
+// ResponseError is returned by executors that build their own requests when
+// the target responds with an error status. Like eth2spec.GenericOpenAPIError,
+// it gives access to the raw response body.
+type ResponseError struct {
+	Status string
+	body   []byte
+}
+
+func (e ResponseError) Error() string {
+	return e.Status
+}
+
+// Body returns the raw bytes of the response.
+func (e ResponseError) Body() []byte {
+	return e.body
+}
+
+type UndeclaredStatusError struct {
+	OperationID string
+	StatusCode  int
+	Declared    []string
+}
+
+func (e UndeclaredStatusError) Error() string {
+	return fmt.Sprintf("Status code %d is not a response declared by the spec for %s. Declared responses: %s", e.StatusCode, e.OperationID, strings.Join(e.Declared, ", "))
+}
+
+type ContentTypeError struct {
+	OperationID string
+	StatusCode  int
+	ContentType string
+	Declared    []string
+}
+
+func (e ContentTypeError) Error() string {
+	return fmt.Sprintf("Content-Type %q is not declared by the spec for a %d response from %s. Declared content types: %s", e.ContentType, e.StatusCode, e.OperationID, strings.Join(e.Declared, ", "))
+}
+
+// ExecOperationOpts describes a request to be sent by ExecOperation.
+type ExecOperationOpts struct {
+	// Route is the spec operation the request is for.
+	Route apispec.Route
+	// Path is the request path, with path params filled in.
+	Path        string
+	QueryParams map[string]string
+	ReqBody     interface{}
+	// Strict enables strict validation of the response. See ValidateResponse.
+	Strict bool
+}
+
+// ExecOperation is the executor for operations without a dedicated executor
+// in this package. Rather than decoding the response into a generated OAPI
+// data structure, it sends the request as-is and validates the status code,
+// Content-Type and JSON body of the response directly against the spec.
+//
+// The Response of the result is the body decoded into generic JSON types, and
+// its ResponseDS is nil so that expected bodies are decoded the same way.
+func ExecOperation(ctx context.Context, opts ExecOperationOpts) (*ExecutorResult, error) {
+	httpdata, body, err := doRequest(ctx, opts.Route.Method, opts.Path, opts.QueryParams, opts.ReqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ExecutorResult{
+		Response:   nil,
+		ResponseDS: nil,
+		StatusCode: &httpdata.StatusCode,
+		Header:     httpdata.Header,
+		Body:       body,
+	}
+
+	if err := ValidateResponse(opts.Route, result, opts.Strict); err != nil {
+		return nil, err
+	}
+
+	if len(body) > 0 && isJSON(httpdata.Header.Get("Content-Type")) {
+		if err := json.Unmarshal(body, &result.Response); err != nil {
+			return nil, err
+		}
+	}
+
+	if httpdata.StatusCode >= 300 {
+		return result, ResponseError{Status: httpdata.Status, body: body}
+	}
+
+	return result, nil
+}
+
+// ValidateResponse checks that the status code, Content-Type and body of an
+// executor result are allowed by the spec for the operation. In strict mode,
+// the body must also contain every property its schema declares, and no
+// properties its schema does not declare.
+func ValidateResponse(route apispec.Route, result *ExecutorResult, strict bool) error {
+	response, ok := route.Response(*result.StatusCode)
+	if !ok {
+		return UndeclaredStatusError{
+			OperationID: route.OperationID,
+			StatusCode:  *result.StatusCode,
+			Declared:    route.Statuses(),
+		}
+	}
+
+	// Responses without declared content, like those of getHealth, have no
+	// body to validate.
+	if len(response.Content) == 0 {
+		return nil
+	}
+
+	contentType := result.Header.Get("Content-Type")
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+	schema, ok := response.Content[mediaType]
+	if !ok {
+		declared := []string{}
+		for declaredType := range response.Content {
+			declared = append(declared, declaredType)
+		}
+		sort.Strings(declared)
+		return ContentTypeError{
+			OperationID: route.OperationID,
+			StatusCode:  *result.StatusCode,
+			ContentType: contentType,
+			Declared:    declared,
+		}
+	}
+
+	if !isJSON(mediaType) || schema == nil {
+		return nil
+	}
+
+	var violations []apispec.Violation
+	if strict {
+		violations, err = schema.ValidateJSONStrict(result.Body)
+	} else {
+		violations, err = schema.ValidateJSON(result.Body)
+	}
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		return apispec.SchemaError{Violations: violations}
+	}
+
+	return nil
+}
+
+// postJSON POSTs body as JSON to path on the target. It is used in place of
+// generated client methods which do not send the request described by the
+// spec.
+func postJSON(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	httpdata, respBody, err := doRequest(ctx, http.MethodPost, path, nil, body)
+	if err != nil {
+		return httpdata, err
+	}
+
+	if httpdata.StatusCode >= 300 {
+		return httpdata, ResponseError{Status: httpdata.Status, body: respBody}
+	}
+
+	return httpdata, nil
+}
+
+// getJSON GETs path on the target and decodes the JSON response body into v.
+// It is used in place of generated client methods which do not send the
+// request described by the spec.
+func getJSON(ctx context.Context, path string, queryParams map[string]string, v interface{}) (*http.Response, error) {
+	httpdata, respBody, err := doRequest(ctx, http.MethodGet, path, queryParams, nil)
+	if err != nil {
+		return httpdata, err
+	}
+
+	if httpdata.StatusCode >= 300 {
+		return httpdata, ResponseError{Status: httpdata.Status, body: respBody}
+	}
+
+	if err := json.Unmarshal(respBody, v); err != nil {
+		return httpdata, ResponseError{Status: err.Error(), body: respBody}
+	}
+
+	return httpdata, nil
+}
+
+// GetJSON GETs path on the target and decodes the JSON response body into v.
+// Unlike the executors, it does not validate the response against the spec,
+// so it suits reading the state of the target rather than testing it.
+func GetJSON(ctx context.Context, path string, queryParams map[string]string, v interface{}) error {
+	_, err := getJSON(ctx, path, queryParams, v)
+	return err
+}
+
+// doRequest sends a request to path on the target, using the configuration of
+// the OAPI client in ctx, and returns the response and its body. If reqBody
+// is not nil, it is sent as JSON.
+func doRequest(ctx context.Context, method, path string, queryParams map[string]string, reqBody interface{}) (*http.Response, []byte, error) {
+	cfg := GetClient(ctx).GetConfig()
+
+	var body io.Reader
+	if reqBody != nil {
+		data, err := json.Marshal(reqBody)
+		if err != nil {
+			return nil, nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	query := url.Values{}
+	for key, value := range queryParams {
+		query.Set(key, value)
+	}
+	reqUrl := cfg.BasePath + path
+	if len(query) > 0 {
+		reqUrl = reqUrl + "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, reqUrl, body)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = req.WithContext(ctx)
+	for header, value := range cfg.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", cfg.UserAgent)
+
+	httpdata, err := cfg.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(httpdata.Body)
+	httpdata.Body.Close()
+	if err != nil {
+		return httpdata, nil, err
+	}
+
+	return httpdata, respBody, nil
+}
+
+// isJSON reports whether a Content-Type or media type is a JSON type.
+func isJSON(contentType string) bool {
+	return strings.Contains(strings.ToLower(contentType), "json")
+}
@@ -0,0 +1,168 @@
+package oapi
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const recordingKey key = 1
+
+// Recording holds the response to the first request made with a context from
+// WithRecording, by a client created with WithClient, and its timing.
+type Recording struct {
+	mu       sync.Mutex
+	sent     time.Time
+	received time.Time
+	resp     *http.Response
+}
+
+// WithRecording returns a copy of ctx in which the first request is recorded
+// by the returned Recording.
+func WithRecording(ctx context.Context) (context.Context, *Recording) {
+	recording := &Recording{}
+	return context.WithValue(ctx, recordingKey, recording), recording
+}
+
+// Latency returns the time from sending the recorded request to reading the
+// last of its response body, or closing the body unread. It returns zero if
+// no response has been read yet.
+func (r *Recording) Latency() time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.received.IsZero() {
+		return 0
+	}
+	return r.received.Sub(r.sent)
+}
+
+// Result returns the raw status code, headers and body of the recorded
+// response, with no decoded Response, or nil if there is no response. It is
+// for requests whose executors return no result, like those answered with
+// error statuses.
+func (r *Recording) Result() *ExecutorResult {
+	r.mu.Lock()
+	resp := r.resp
+	r.mu.Unlock()
+
+	if resp == nil {
+		return nil
+	}
+	return &ExecutorResult{
+		StatusCode: &resp.StatusCode,
+		Header:     resp.Header,
+		Body:       RawBody(resp),
+		Latency:    r.Latency(),
+	}
+}
+
+// send records the time a request is sent, and reports whether it is the
+// first.
+func (r *Recording) send() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.sent.IsZero() {
+		return false
+	}
+	r.sent = time.Now()
+	return true
+}
+
+// receive records the time the response body has been read, unless it was
+// recorded already.
+func (r *Recording) receive() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.received.IsZero() {
+		r.received = time.Now()
+	}
+}
+
+// recordingTransport wraps the bodies of responses so that their raw bytes are
+// still available after the generated client has read and decoded them. The
+// generated data structures decode leniently, so the raw bytes are what must
+// be validated against the spec.
+type recordingTransport struct {
+	base http.RoundTripper
+}
+
+func (t recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recording, _ := req.Context().Value(recordingKey).(*Recording)
+	if recording != nil && !recording.send() {
+		recording = nil
+	}
+
+	release, err := acquireRequest(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if resp == nil || resp.Body == nil || isEventStream(resp) {
+		release()
+	}
+	if resp != nil && resp.Body != nil {
+		// Event streams stay open for as long as they are listened to, so
+		// their bodies are not kept.
+		resp.Body = &recordedBody{ReadCloser: resp.Body, recording: recording, release: release, keep: !isEventStream(resp)}
+	}
+	if resp != nil && recording != nil {
+		recording.mu.Lock()
+		recording.resp = resp
+		recording.mu.Unlock()
+	}
+	return resp, err
+}
+
+// recordedBody is a response body which keeps a copy of everything read from
+// it, if keep is true. When it has been read, it tells its Recording, if any,
+// and gives up the place of its request among the requests in flight.
+type recordedBody struct {
+	io.ReadCloser
+	keep      bool
+	read      bytes.Buffer
+	recording *Recording
+	release   func()
+}
+
+func (b *recordedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if b.keep {
+		b.read.Write(p[:n])
+	}
+	if err != nil {
+		b.done()
+	}
+	return n, err
+}
+
+func (b *recordedBody) Close() error {
+	b.done()
+	return b.ReadCloser.Close()
+}
+
+func (b *recordedBody) done() {
+	if b.recording != nil {
+		b.recording.receive()
+	}
+	b.release()
+}
+
+// RawBody returns the bytes read so far from the body of a response received
+// by a client created with WithClient. It returns nil for event streams, whose
+// bodies are not kept, and for other responses.
+func RawBody(httpdata *http.Response) []byte {
+	if httpdata == nil {
+		return nil
+	}
+	if body, ok := httpdata.Body.(*recordedBody); ok {
+		return body.read.Bytes()
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// Run describes a run of the test suite as a whole.
+type Run struct {
+	// Target is the URL of the target the suite was run against.
+	Target string
+	// CompareTargets are the URLs of further targets whose responses were
+	// compared to those of Target.
+	CompareTargets []string
+	// NodeVersion is the version the target reported for itself.
+	NodeVersion string
+	// Suite is where the test cases came from, either a directory or the URL
+	// of a zip file.
+	Suite string
+	Start time.Time
+	End   time.Time
+}
+
+type jsonReport struct {
+	Target         string      `json:"target"`
+	CompareTargets []string    `json:"compareTargets,omitempty"`
+	NodeVersion    string      `json:"nodeVersion"`
+	Suite          string      `json:"suite"`
+	Start          time.Time   `json:"start"`
+	End            time.Time   `json:"end"`
+	Summary        jsonSummary `json:"summary"`
+	Cases          []jsonCase  `json:"cases"`
+}
+
+type jsonSummary struct {
+	Total    int `json:"total"`
+	Passed   int `json:"passed"`
+	Failed   int `json:"failed"`
+	TimedOut int `json:"timedOut"`
+	Skipped  int `json:"skipped"`
+}
+
+type jsonCase struct {
+	Namespace      string            `json:"namespace"`
+	Scenario       string            `json:"scenario,omitempty"`
+	Method         string            `json:"method"`
+	Route          string            `json:"route"`
+	QueryParams    map[string]string `json:"queryParams,omitempty"`
+	Status         string            `json:"status"`
+	ErrorKind      string            `json:"errorKind,omitempty"`
+	Error          string            `json:"error,omitempty"`
+	ExpectedStatus int               `json:"expectedStatus,omitempty"`
+	ActualStatus   int               `json:"actualStatus,omitempty"`
+	Headers        http.Header       `json:"headers,omitempty"`
+	DurationMs     float64           `json:"durationMs"`
+	SlotWaitMs     float64           `json:"slotWaitMs"`
+	LatencyMs      float64           `json:"latencyMs"`
+}
+
+// Case statuses in JSON reports.
+const (
+	statusPassed   = "passed"
+	statusFailed   = "failed"
+	statusTimedOut = "timedOut"
+	statusSkipped  = "skipped"
+)
+
+// WriteJSON writes the results of executed cases as a JSON document, along
+// with metadata about the run.
+func WriteJSON(w io.Writer, run Run, cases []*testcases.Case) error {
+	doc := jsonReport{
+		Target:         run.Target,
+		CompareTargets: run.CompareTargets,
+		NodeVersion:    run.NodeVersion,
+		Suite:          run.Suite,
+		Start:          run.Start,
+		End:            run.End,
+		Cases:          []jsonCase{},
+	}
+
+	for _, c := range cases {
+		entry := jsonCase{
+			Namespace:      namespace(c),
+			Method:         c.Config.Method,
+			Route:          c.Config.Route,
+			QueryParams:    c.Config.QueryParams,
+			ExpectedStatus: c.Config.ExpectedRespStatus,
+			ActualStatus:   c.Result.StatusCode,
+			Headers:        c.Result.Header,
+			DurationMs:     milliseconds(c.Result.Elapsed),
+			SlotWaitMs:     milliseconds(c.Result.SlotWait),
+			LatencyMs:      milliseconds(c.Result.Latency),
+		}
+		if len(c.Config.Steps) > 0 {
+			entry.Scenario = c.Name()
+		}
+
+		switch {
+		case c.Skipped:
+			entry.Status = statusSkipped
+			doc.Summary.Skipped++
+		case c.Result.Success:
+			entry.Status = statusPassed
+			doc.Summary.Passed++
+		case c.Result.TimedOut:
+			entry.Status = statusTimedOut
+			doc.Summary.TimedOut++
+		default:
+			entry.Status = statusFailed
+			doc.Summary.Failed++
+		}
+		if !c.Skipped && c.Result.Error != nil {
+			entry.ErrorKind = errorKind(c.Result.Error)
+			entry.Error = c.Result.Error.Error()
+		}
+
+		doc.Summary.Total++
+		doc.Cases = append(doc.Cases, entry)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(doc)
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// errorKind classifies the error of an unsuccessful Result, so that reports
+// can be aggregated by what went wrong.
+func errorKind(err error) string {
+	// A scenario fails with the error of its failed step.
+	if stepErr, ok := err.(testcases.StepError); ok {
+		return errorKind(stepErr.Err)
+	}
+
+	switch err.(type) {
+	case testcases.TimeoutError:
+		return "timeout"
+	case testcases.StatusMismatchError:
+		return "statusMismatch"
+	case testcases.BodyMismatchError:
+		return "bodyMismatch"
+	case testcases.LatencyError:
+		return "latency"
+	case testcases.HeaderMismatchError:
+		return "headerMismatch"
+	case testcases.AssertionError:
+		return "assertion"
+	case testcases.DifferenceError:
+		return "difference"
+	case testcases.CaptureError, testcases.UndefinedVariableError, testcases.VariableError:
+		return "variable"
+	case testcases.UnimplementedOperationError:
+		return "unimplemented"
+	case testcases.OapiError, oapi.ResponseError:
+		return "errorResponse"
+	case apispec.SchemaError:
+		return "schema"
+	case oapi.UndeclaredStatusError:
+		return "undeclaredStatus"
+	case oapi.ContentTypeError:
+		return "contentType"
+	case oapi.EventError, oapi.MissingEventsError:
+		return "event"
+	case target.BadTargetError, *target.ClientMissingTargetSlotErr, *target.TargetLaggingErr, target.UnmetConditionError:
+		return "target"
+	case *url.Error:
+		return "network"
+	}
+	return "other"
+}
@@ -0,0 +1,130 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+
+	elapsed float64
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *struct{}     `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// WriteJUnit writes the results of executed cases as a JUnit XML document, with
+// one testsuite per API namespace (beacon, node, config, ...).
+func WriteJUnit(w io.Writer, cases []*testcases.Case) error {
+	suites := map[string]*junitTestSuite{}
+	root := junitTestSuites{Name: "eth2-comply"}
+	var total float64
+
+	for _, c := range cases {
+		name := namespace(c)
+		suite, ok := suites[name]
+		if !ok {
+			suite = &junitTestSuite{Name: name}
+			suites[name] = suite
+		}
+
+		testCase := junitTestCase{
+			Name:      c.Name(),
+			Classname: name,
+			Time:      seconds(c.Result.Elapsed.Seconds()),
+		}
+		switch {
+		case c.Skipped:
+			testCase.Skipped = &struct{}{}
+			suite.Skipped++
+		case !c.Result.Success:
+			testCase.Failure = junitFailureFor(c.Result)
+			suite.Failures++
+		}
+
+		suite.Tests++
+		suite.Cases = append(suite.Cases, testCase)
+		suite.elapsed += c.Result.Elapsed.Seconds()
+		total += c.Result.Elapsed.Seconds()
+	}
+
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		suite := suites[name]
+		suite.Time = seconds(suite.elapsed)
+
+		root.Tests += suite.Tests
+		root.Failures += suite.Failures
+		root.Skipped += suite.Skipped
+		root.Suites = append(root.Suites, *suite)
+	}
+	root.Time = seconds(total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// junitFailureFor describes an unsuccessful Result. The message is the first
+// line of the error, and the text is the whole error.
+func junitFailureFor(result testcases.Result) *junitFailure {
+	failure := &junitFailure{Type: "failure"}
+	if result.TimedOut {
+		failure.Type = "timeout"
+	}
+	if result.Error != nil {
+		text := result.Error.Error()
+		failure.Message = strings.SplitN(text, "\n", 2)[0]
+		failure.Text = text
+	}
+	return failure
+}
+
+// seconds formats a number of seconds the way JUnit reports expect.
+func seconds(s float64) string {
+	return fmt.Sprintf("%.3f", s)
+}
@@ -0,0 +1,37 @@
+// package report writes the results of executed test cases in formats meant
+// for other programs, like CI systems and dashboards, rather than for people.
+package report
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// namespace returns the API namespace of a Case's route, e.g. "beacon" for
+// "/eth/v1/beacon/genesis". Routes which are not in the spec are in the
+// "other" namespace, and scenarios in the "scenario" namespace.
+func namespace(c *testcases.Case) string {
+	if len(c.Config.Steps) > 0 {
+		return "scenario"
+	}
+
+	route, err := url.Parse(c.Config.Route)
+	if err != nil {
+		return "other"
+	}
+
+	specRoute, _, ok := apispec.MatchRoute(c.Config.Method, route.Path)
+	if !ok {
+		return "other"
+	}
+
+	// Spec paths look like /eth/v1/<namespace>/...
+	segments := strings.Split(strings.Trim(specRoute.Path, "/"), "/")
+	if len(segments) < 3 {
+		return "other"
+	}
+	return segments[2]
+}
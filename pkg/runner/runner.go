@@ -0,0 +1,262 @@
+// package runner runs a suite of test cases against a target the way the
+// eth2-comply command does, for programs and Go tests which embed eth2-comply
+// rather than run its binary. See package runnertest for running a suite under
+// go test.
+package runner
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/report"
+	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// DefaultTestsRemote is the URL of the zip file of test cases which is used if
+// no suite is given.
+const DefaultTestsRemote = "https://github.com/INFURA/eth2-comply/releases/download/v0.3.1/tests-v0.3.1.zip"
+
+// lagTolerance is how many slots the target's head may be behind the wall
+// clock before a warning is given. Slots without blocks mean a synced target
+// is often a slot or two behind.
+const lagTolerance = 2
+
+// Opts configures a Runner. Zero durations mean no bound besides the context
+// passed to Start.
+type Opts struct {
+	// Targets are the URLs of the beacon nodes to run the cases against,
+	// e.g. "http://localhost:5051". Expectations are checked against the
+	// first, and the responses of any others must agree with its responses.
+	Targets []string
+	// TestsRoot is a directory tree of test cases. If it is empty, the cases
+	// are read from the zip file at TestsRemote, which is downloaded and
+	// unzipped into OutDir. TestsRemote defaults to DefaultTestsRemote, and
+	// OutDir to the temporary directory.
+	TestsRoot   string
+	TestsRemote string
+	OutDir      string
+	// Subset is a path prefix, e.g. "/eth/v1/node". Cases whose routes are
+	// not beneath it are skipped. Defaults to "/".
+	Subset string
+	// Filter, if set, selects the cases to run by their configs. Cases it
+	// rejects are left out of the Runner altogether.
+	Filter func(config testcases.CaseConfig) bool
+	// Concurrency is the maximum number of requests in flight at once,
+	// including those looking up variables, cross-checking events and
+	// comparing targets. Cases waiting for their await slots or conditions
+	// do not count towards it. Values less than one do not limit requests.
+	Concurrency int
+	// Strict validates every response against the spec schema, failing on
+	// unknown, missing and malformed fields.
+	Strict bool
+	// Timeout bounds the execution of all cases, after the targets are
+	// healthy.
+	Timeout time.Duration
+	// HealthTimeout bounds the wait for each target to report itself as
+	// healthy.
+	HealthTimeout time.Duration
+	// SlotTimeout bounds the wait of each case for its await slot and other
+	// conditions.
+	SlotTimeout time.Duration
+	// RequestTimeout bounds the execution of each case's request, unless
+	// the case sets its own timeout.
+	RequestTimeout time.Duration
+	// Warn, if set, is called with problems which do not stop the cases from
+	// running, like the first target lagging behind the wall clock.
+	Warn func(err error)
+}
+
+// Results are the outcome of running a suite.
+type Results struct {
+	report.Run
+	// Cases are the executed cases, whose Results hold their verdicts.
+	Cases []*testcases.Case
+}
+
+// Failed returns the cases which were executed and did not succeed, including
+// those which timed out.
+func (r *Results) Failed() []*testcases.Case {
+	failed := []*testcases.Case{}
+	for _, c := range r.Cases {
+		if !c.Skipped && !c.Result.Success {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// Success reports whether every case which was executed succeeded.
+func (r *Results) Success() bool {
+	return len(r.Failed()) == 0
+}
+
+// Runner runs the cases of a suite against the targets of its Opts. A Runner
+// is single-use: its cases can only be executed once.
+type Runner struct {
+	opts    Opts
+	targets []url.URL
+	suite   string
+	cases   []*testcases.Case
+
+	nodeVersion string
+	start       time.Time
+	cancel      context.CancelFunc
+}
+
+// New returns a Runner configured by opts, with its cases read from the suite.
+// It returns an error if a target is not a URL, or the suite cannot be read
+// or has an ill-formed case.
+func New(opts Opts) (*Runner, error) {
+	if len(opts.Targets) == 0 {
+		return nil, fmt.Errorf("At least one target is required")
+	}
+	if opts.TestsRemote == "" {
+		opts.TestsRemote = DefaultTestsRemote
+	}
+	if opts.OutDir == "" {
+		opts.OutDir = os.TempDir()
+	}
+	if opts.Subset == "" {
+		opts.Subset = "/"
+	}
+
+	targets := []url.URL{}
+	for _, loc := range opts.Targets {
+		u, err := url.Parse(loc)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, *u)
+	}
+
+	all, err := testcases.All(&testcases.TestsCasesOpts{
+		Target:         opts.Targets[0],
+		TestsRoot:      opts.TestsRoot,
+		TestsRemote:    opts.TestsRemote,
+		OutDir:         opts.OutDir,
+		Strict:         opts.Strict,
+		SlotTimeout:    opts.SlotTimeout,
+		RequestTimeout: opts.RequestTimeout,
+		CompareTargets: targets[1:],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cases := []*testcases.Case{}
+	for _, c := range all {
+		if opts.Filter == nil || opts.Filter(c.Config) {
+			cases = append(cases, c)
+		}
+	}
+
+	suite := opts.TestsRoot
+	if suite == "" {
+		suite = opts.TestsRemote
+	}
+
+	return &Runner{
+		opts:    opts,
+		targets: targets,
+		suite:   suite,
+		cases:   cases,
+	}, nil
+}
+
+// Cases returns the cases the Runner runs. Their Done channels are closed as
+// they are executed.
+func (r *Runner) Cases() []*testcases.Case {
+	return r.cases
+}
+
+// Start waits for every target to report itself as healthy, and then starts
+// executing the cases and returns without waiting for them. It returns an
+// error if a target does not become healthy in time.
+func (r *Runner) Start(ctx context.Context) error {
+	for _, u := range r.targets {
+		healthCtx, cancelHealth := testcases.WithTimeout(ctx, r.opts.HealthTimeout)
+		err := target.IsHealthy(oapi.WithClient(healthCtx, u))
+		cancelHealth()
+		if err != nil {
+			return err
+		}
+	}
+
+	// The node version is only informational, so failing to get it does not
+	// stop the cases from running.
+	versionCtx, cancelVersion := testcases.WithTimeout(ctx, r.opts.HealthTimeout)
+	r.nodeVersion, _ = target.NodeVersion(oapi.WithClient(versionCtx, r.targets[0]))
+	cancelVersion()
+
+	ctx, r.cancel = testcases.WithTimeout(ctx, r.opts.Timeout)
+	ctx = oapi.WithClient(ctx, r.targets[0])
+	ctx = WithSlotClock(ctx, r.opts.Warn)
+
+	// Share one poller of the target's head slot between all cases waiting
+	// for a slot.
+	slotWatcher := target.NewSlotWatcher(time.Second)
+	go slotWatcher.Run(ctx)
+	ctx = target.WithSlotWatcher(ctx, slotWatcher)
+	// Likewise, share the reads of the state other await conditions depend
+	// on.
+	ctx = target.WithConditionWatcher(ctx, target.NewConditionWatcher(time.Second))
+
+	r.start = time.Now()
+	testcases.ExecAll(ctx, r.cases, r.opts.Subset, r.opts.Concurrency)
+	return nil
+}
+
+// Wait waits for the cases started by Start to be executed, and returns the
+// Results.
+func (r *Runner) Wait() *Results {
+	for _, c := range r.cases {
+		<-c.Done
+	}
+	end := time.Now()
+	r.cancel()
+
+	return &Results{
+		Run: report.Run{
+			Target:         r.opts.Targets[0],
+			CompareTargets: r.opts.Targets[1:],
+			NodeVersion:    r.nodeVersion,
+			Suite:          r.suite,
+			Start:          r.start,
+			End:            end,
+		},
+		Cases: r.cases,
+	}
+}
+
+// Run executes the cases, as Start does, and waits for their Results.
+func (r *Runner) Run(ctx context.Context) (*Results, error) {
+	if err := r.Start(ctx); err != nil {
+		return nil, err
+	}
+	return r.Wait(), nil
+}
+
+// WithSlotClock returns a copy of ctx carrying the slot clock of the target in
+// ctx, so that waits for slots are scheduled by it. If warn is set, it is
+// called if the clock cannot be read, in which case ctx is returned unchanged,
+// and if the target's head is behind the wall clock.
+func WithSlotClock(ctx context.Context, warn func(err error)) context.Context {
+	if warn == nil {
+		warn = func(err error) {}
+	}
+
+	clock, err := target.ReadSlotClock(ctx)
+	if err != nil {
+		warn(fmt.Errorf("cannot read the slot clock of the target: %s", err))
+		return ctx
+	}
+	if err := clock.CheckLag(ctx, lagTolerance); err != nil {
+		warn(err)
+	}
+	return target.WithSlotClock(ctx, clock)
+}
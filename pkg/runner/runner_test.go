@@ -0,0 +1,82 @@
+package runner_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/runner"
+	"github.com/INFURA/eth2-comply/pkg/selftest"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+func newServer(t *testing.T) *selftest.Server {
+	t.Helper()
+	s, err := selftest.NewServer(mock.Opts{
+		Genesis: time.Now().Add(-100 * 12 * time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestRun(t *testing.T) {
+	s := newServer(t)
+
+	r, err := runner.New(runner.Opts{
+		Targets:       []string{s.URL},
+		TestsRoot:     "testdata/suite",
+		Subset:        "/eth/v1/node",
+		Timeout:       10 * time.Second,
+		HealthTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(results.Cases) != 3 {
+		t.Fatalf("expected 3 cases, got %d", len(results.Cases))
+	}
+	for _, c := range results.Cases {
+		switch c.Config.Source {
+		case "testdata/suite/beacon/genesis.json":
+			if !c.Skipped {
+				t.Errorf("expected %s outside the subset to be skipped", c.Config.Source)
+			}
+		case "testdata/suite/node/version.json":
+			if !c.Result.Success {
+				t.Errorf("expected %s to pass, got: %s", c.Config.Source, c.Result.Error)
+			}
+		case "testdata/suite/node/version_wrong_status.json":
+			if _, ok := c.Result.Error.(testcases.StatusMismatchError); !ok {
+				t.Errorf("expected %s to fail with a StatusMismatchError, got: %v", c.Config.Source, c.Result.Error)
+			}
+		}
+	}
+
+	if results.Success() {
+		t.Error("expected the results not to be successful")
+	}
+	if failed := results.Failed(); len(failed) != 1 {
+		t.Errorf("expected 1 failed case, got %d", len(failed))
+	}
+	if results.Target != s.URL {
+		t.Errorf("expected the target to be %s, got %s", s.URL, results.Target)
+	}
+	if results.NodeVersion != mock.Version {
+		t.Errorf("expected the node version to be %s, got %s", mock.Version, results.NodeVersion)
+	}
+}
+
+func TestNewWithoutTargets(t *testing.T) {
+	if _, err := runner.New(runner.Opts{TestsRoot: "testdata/suite"}); err == nil {
+		t.Fatal("expected an error without targets")
+	}
+}
@@ -0,0 +1,72 @@
+// package runnertest runs suites of test cases under go test, with a subtest
+// for each case. It is kept apart from package runner so that programs
+// embedding the runner do not link package testing.
+package runnertest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/INFURA/eth2-comply/pkg/runner"
+)
+
+// Test runs the suite configured by opts as subtests of t, one per case and
+// named after it. Cases are executed concurrently, as opts configures, and
+// each subtest fails if its case does, or is skipped if its case is not beneath
+// opts.Subset. Warnings are logged to t unless opts.Warn is set.
+//
+// For example, a client's integration tests can check it against the suite
+// with:
+//
+//	func TestCompliance(t *testing.T) {
+//		runnertest.Test(t, runner.Opts{
+//			Targets:   []string{"http://localhost:5051"},
+//			TestsRoot: "testdata/eth2-comply",
+//		})
+//	}
+func Test(t *testing.T, opts runner.Opts) {
+	t.Helper()
+
+	if opts.Warn == nil {
+		opts.Warn = func(err error) {
+			t.Logf("Warning: %s", err)
+		}
+	}
+
+	r, err := runner.New(opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	Run(t, r)
+}
+
+// Run runs the cases of r as subtests of t, as Test does.
+func Run(t *testing.T, r *runner.Runner) {
+	t.Helper()
+
+	// Cases are named before they start executing, as naming a Case reads
+	// it while Exec writes its Result.
+	cases := r.Cases()
+	names := make([]string, len(cases))
+	for i, c := range cases {
+		names[i] = c.Name()
+	}
+
+	if err := r.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Wait()
+
+	for i, c := range cases {
+		c := c
+		t.Run(names[i], func(t *testing.T) {
+			<-c.Done
+			switch {
+			case c.Skipped:
+				t.Skip("The case is not beneath the subset of paths to run tests for")
+			case !c.Result.Success:
+				t.Error(c.Result.Error)
+			}
+		})
+	}
+}
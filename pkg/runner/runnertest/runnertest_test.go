@@ -0,0 +1,28 @@
+package runnertest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/runner"
+	"github.com/INFURA/eth2-comply/pkg/runner/runnertest"
+	"github.com/INFURA/eth2-comply/pkg/selftest"
+)
+
+func TestTest(t *testing.T) {
+	s, err := selftest.NewServer(mock.Opts{
+		Genesis: time.Now().Add(-100 * 12 * time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	runnertest.Test(t, runner.Opts{
+		Targets:       []string{s.URL},
+		TestsRoot:     "testdata/suite",
+		Timeout:       10 * time.Second,
+		HealthTimeout: 10 * time.Second,
+	})
+}
@@ -0,0 +1,115 @@
+// package selftest runs test cases in-process against a beacon node served by
+// an httptest.Server, through the same Exec path as the eth2-comply command.
+// The node is a mock.Node, whose responses can be replaced operation by
+// operation, so that Go tests can assert the verdict eth2-comply reaches for a
+// given server behavior.
+package selftest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// Server is a beacon node listening on a local address. Requests are answered
+// by the handler registered for their method and path with Handle, if any,
+// and otherwise by Node.
+type Server struct {
+	*httptest.Server
+	Node *mock.Node
+
+	mu       sync.Mutex
+	handlers map[string]http.Handler
+}
+
+// NewServer starts and returns a Server whose Node is configured by opts. The
+// caller should call Close when finished, to shut it down.
+func NewServer(opts mock.Opts) (*Server, error) {
+	node, err := mock.New(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		Node:     node,
+		handlers: map[string]http.Handler{},
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s, nil
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	handler, ok := s.handlers[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	if !ok {
+		handler = s.Node
+	}
+	handler.ServeHTTP(w, r)
+}
+
+// Handle makes handler answer the requests with method and path, e.g. "GET"
+// and "/eth/v1/node/version", whatever their query params. A nil handler
+// makes Node answer them again.
+func (s *Server) Handle(method, path string, handler http.Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if handler == nil {
+		delete(s.handlers, method+" "+path)
+		return
+	}
+	s.handlers[method+" "+path] = handler
+}
+
+// Respond makes the requests with method and path answered with status and a
+// body, which is encoded as JSON unless it is a string or []byte. A nil body
+// makes the responses empty.
+func (s *Server) Respond(method, path string, status int, body interface{}) {
+	s.Handle(method, path, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data []byte
+		switch body := body.(type) {
+		case nil:
+		case string:
+			data = []byte(body)
+		case []byte:
+			data = body
+		default:
+			var err error
+			data, err = json.Marshal(body)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		if data != nil {
+			w.Header().Set("Content-Type", "application/json")
+		}
+		w.WriteHeader(status)
+		w.Write(data)
+	}))
+}
+
+// Context returns a copy of ctx carrying an OAPI client for the Server, which
+// cases executed with it make their requests to.
+func (s *Server) Context(ctx context.Context) context.Context {
+	u, _ := url.Parse(s.URL)
+	return oapi.WithClient(ctx, *u)
+}
+
+// Exec executes a Case with config against the Server, and returns it once it
+// is done. Its Result holds the verdict.
+func (s *Server) Exec(ctx context.Context, config testcases.CaseConfig) *testcases.Case {
+	c := testcases.NewCase(config)
+	c.Exec(s.Context(ctx), "/")
+	return c
+}
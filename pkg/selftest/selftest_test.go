@@ -0,0 +1,57 @@
+package selftest_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/selftest"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+func TestServer(t *testing.T) {
+	s, err := selftest.NewServer(mock.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	config := testcases.CaseConfig{
+		Method:             "GET",
+		Route:              "/eth/v1/node/version",
+		ExpectedRespStatus: 200,
+		ExpectedRespBody:   map[string]interface{}{"data": map[string]interface{}{"version": mock.Version}},
+	}
+
+	if c := s.Exec(context.Background(), config); !c.Result.Success {
+		t.Fatalf("expected the Node's response to pass, got: %s", c.Result.Error)
+	}
+
+	s.Respond("GET", "/eth/v1/node/version", http.StatusOK, map[string]interface{}{
+		"data": map[string]interface{}{"version": "other/v1.0.0"},
+	})
+	c := s.Exec(context.Background(), config)
+	if _, ok := c.Result.Error.(testcases.BodyMismatchError); !ok {
+		t.Fatalf("expected a BodyMismatchError for the replaced response, got: %v", c.Result.Error)
+	}
+
+	s.Handle("GET", "/eth/v1/node/version", nil)
+	if c := s.Exec(context.Background(), config); !c.Result.Success {
+		t.Fatalf("expected the Node's response to pass once the handler is removed, got: %s", c.Result.Error)
+	}
+}
+
+func TestRespondRaw(t *testing.T) {
+	s, err := selftest.NewServer(mock.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	s.Respond("GET", "/eth/v1/node/version", http.StatusOK, `{"data": {"version": `)
+	c := s.Exec(context.Background(), testcases.CaseConfig{Method: "GET", Route: "/eth/v1/node/version"})
+	if c.Result.Success {
+		t.Fatal("expected a malformed response body to fail")
+	}
+}
@@ -0,0 +1,214 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+const slotClockKey contextKey = 1
+
+type TargetLaggingErr struct {
+	Current   int
+	WallClock int
+	// Target is the slot that was waited for, if any.
+	Target int
+}
+
+func (e *TargetLaggingErr) Error() string {
+	msg := fmt.Sprintf("Target is at slot %d, %d slots behind the wall clock slot %d.", e.Current, e.WallClock-e.Current, e.WallClock)
+	if e.Target > 0 {
+		msg = fmt.Sprintf("%s Needs slot %d.", msg, e.Target)
+	}
+	return msg
+}
+
+// SlotClock tells the slot and epoch of the chain by the wall clock, so that
+// waits for slots can be scheduled for when the slots begin, and a target
+// which is behind the wall clock can be told apart from a chain which has not
+// reached a slot yet.
+type SlotClock struct {
+	Genesis       time.Time
+	SlotDuration  time.Duration
+	SlotsPerEpoch int
+}
+
+// ReadSlotClock returns the SlotClock of the target's chain, from the
+// genesis_time of /eth/v1/beacon/genesis and the SECONDS_PER_SLOT and
+// SLOTS_PER_EPOCH of /eth/v1/config/spec.
+func ReadSlotClock(ctx context.Context) (*SlotClock, error) {
+	genesis, err := getGenesisTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	secondsPerSlot, err := getSpecInt(ctx, "SECONDS_PER_SLOT")
+	if err != nil {
+		return nil, err
+	}
+	slotsPerEpoch, err := getSpecInt(ctx, "SLOTS_PER_EPOCH")
+	if err != nil {
+		return nil, err
+	}
+
+	return &SlotClock{
+		Genesis:       genesis,
+		SlotDuration:  time.Duration(secondsPerSlot) * time.Second,
+		SlotsPerEpoch: slotsPerEpoch,
+	}, nil
+}
+
+// WithSlotClock returns a context in which waits for slots are scheduled by
+// the provided SlotClock.
+func WithSlotClock(ctx context.Context, c *SlotClock) context.Context {
+	return context.WithValue(ctx, slotClockKey, c)
+}
+
+// getSlotClock returns the SlotClock in the provided context, if any.
+func getSlotClock(ctx context.Context) *SlotClock {
+	c, _ := ctx.Value(slotClockKey).(*SlotClock)
+	return c
+}
+
+// slotClock returns the SlotClock in the provided context, or reads it from
+// the target if there is none.
+func slotClock(ctx context.Context) (*SlotClock, error) {
+	if c := getSlotClock(ctx); c != nil {
+		return c, nil
+	}
+	return ReadSlotClock(ctx)
+}
+
+// CurrentSlot returns the slot the wall clock is in. It is zero before
+// genesis.
+func (c *SlotClock) CurrentSlot() int {
+	since := time.Since(c.Genesis)
+	if since < 0 {
+		return 0
+	}
+	return int(since / c.SlotDuration)
+}
+
+// SlotStart returns the time at which slot begins.
+func (c *SlotClock) SlotStart(slot int) time.Time {
+	return c.Genesis.Add(time.Duration(slot) * c.SlotDuration)
+}
+
+// Epoch returns the epoch of slot.
+func (c *SlotClock) Epoch(slot int) int {
+	return slot / c.SlotsPerEpoch
+}
+
+// EpochStart returns the first slot of epoch.
+func (c *SlotClock) EpochStart(epoch int) int {
+	return epoch * c.SlotsPerEpoch
+}
+
+// CheckLag returns a *TargetLaggingErr if the head slot of the target is more
+// than tolerance slots behind the wall clock. Slots without blocks mean that
+// even a synced target is often a slot or two behind.
+func (c *SlotClock) CheckLag(ctx context.Context, tolerance int) error {
+	headSlot, _, err := getHeadSlotAndSyncDistance(ctx)
+	if err != nil {
+		return err
+	}
+
+	if wallClock := c.CurrentSlot(); wallClock-headSlot > tolerance {
+		return &TargetLaggingErr{Current: headSlot, WallClock: wallClock}
+	}
+	return nil
+}
+
+// waitForSlotStart blocks until slot begins by the SlotClock in ctx, if there
+// is one, since no target can sync a slot before then. It returns a
+// *ClientMissingTargetSlotErr if ctx is done first.
+func waitForSlotStart(ctx context.Context, slot int) error {
+	c := getSlotClock(ctx)
+	if c == nil {
+		return nil
+	}
+	wait := time.Until(c.SlotStart(slot))
+	if wait <= 0 {
+		return nil
+	}
+
+	// The head is the last one the SlotWatcher has seen, if there is one,
+	// rather than read from the target by every waiter.
+	w := getSlotWatcher(ctx)
+	var headSlot int
+	var known bool
+	if w != nil {
+		w.refresh()
+		headSlot, known = w.latest()
+	} else {
+		var err error
+		headSlot, _, err = getHeadSlotAndSyncDistance(ctx)
+		known = err == nil
+	}
+	if known && headSlot >= slot {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		if w != nil {
+			headSlot, known = w.latest()
+		}
+		return &ClientMissingTargetSlotErr{Current: headSlot, Target: slot, CurrentUnknown: !known}
+	case <-time.After(wait):
+		return nil
+	}
+}
+
+// explainMissingSlot returns a *TargetLaggingErr in place of a
+// *ClientMissingTargetSlotErr if, by the SlotClock in ctx, the slot that was
+// waited for has already begun. The target is then behind the wall clock,
+// rather than the chain not having reached the slot yet.
+func explainMissingSlot(ctx context.Context, err *ClientMissingTargetSlotErr) error {
+	c := getSlotClock(ctx)
+	if c == nil || err.CurrentUnknown {
+		return err
+	}
+	if wallClock := c.CurrentSlot(); wallClock >= err.Target {
+		return &TargetLaggingErr{Current: err.Current, WallClock: wallClock, Target: err.Target}
+	}
+	return err
+}
+
+// getSpecInt returns an integer value of the target's spec config, e.g.
+// SLOTS_PER_EPOCH.
+func getSpecInt(ctx context.Context, name string) (int, error) {
+	response := struct {
+		Data map[string]interface{}
+	}{}
+	if err := oapi.GetJSON(ctx, specRoute, nil, &response); err != nil {
+		return 0, BadTargetError{Route: specRoute, Err: err}
+	}
+
+	value, _ := response.Data[name].(string)
+	n, err := strconv.ParseInt(value, 10, 0)
+	if err != nil || n <= 0 {
+		return 0, BadTargetError{Route: specRoute, Err: fmt.Errorf("invalid %s %q", name, value)}
+	}
+	return int(n), nil
+}
+
+// getGenesisTime returns the time of the target's genesis.
+func getGenesisTime(ctx context.Context) (time.Time, error) {
+	response := struct {
+		Data struct {
+			GenesisTime string `json:"genesis_time"`
+		}
+	}{}
+	if err := oapi.GetJSON(ctx, genesisRoute, nil, &response); err != nil {
+		return time.Time{}, BadTargetError{Route: genesisRoute, Err: err}
+	}
+
+	seconds, err := strconv.ParseInt(response.Data.GenesisTime, 10, 64)
+	if err != nil {
+		return time.Time{}, BadTargetError{Route: genesisRoute, Err: err}
+	}
+	return time.Unix(seconds, 0), nil
+}
@@ -0,0 +1,283 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+const conditionWatcherKey contextKey = 2
+
+// pollInterval is how often conditions on the state of the target are
+// checked while waiting for them.
+const pollInterval = time.Second
+
+type UnmetConditionError struct {
+	// Condition is what was waited for, e.g. "finalized epoch 3".
+	Condition string
+	// Current describes the state of the target when waiting stopped, e.g.
+	// "finalized epoch 1".
+	Current string
+	// Err is the last error encountered while checking the condition, if
+	// any.
+	Err error
+}
+
+func (e UnmetConditionError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("Target never reached %s: %s", e.Condition, e.Err.Error())
+	}
+	return fmt.Sprintf("Target never reached %s. It is at %s.", e.Condition, e.Current)
+}
+
+// poll calls check once per pollInterval until it reports that the condition
+// holds, or ctx is done. In the latter case, it returns an
+// UnmetConditionError with the state check last described. Errors from check
+// are treated as transient.
+func poll(ctx context.Context, condition string, check func() (bool, string, error)) error {
+	unmet := UnmetConditionError{Condition: condition}
+	for {
+		ok, current, err := check()
+		if err == nil && ok {
+			return nil
+		}
+		unmet.Current = current
+		unmet.Err = err
+
+		select {
+		case <-ctx.Done():
+			return unmet
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// ConditionWatcher reads the state of the target which conditions are waited
+// for on, like its finalized epoch, on behalf of any number of waiters. Each
+// kind of state is read from the target at most once per interval, however
+// many waiters check it, and only while some waiter does.
+type ConditionWatcher struct {
+	interval time.Duration
+
+	mu       sync.Mutex
+	readings map[string]*reading
+}
+
+// reading is the last value read of a kind of state of the target.
+type reading struct {
+	value interface{}
+	err   error
+	at    time.Time
+	// inFlight, if not nil, is closed when the read in progress finishes.
+	inFlight chan struct{}
+}
+
+// NewConditionWatcher returns a ConditionWatcher which reads each kind of
+// state of the target at most once per interval.
+func NewConditionWatcher(interval time.Duration) *ConditionWatcher {
+	return &ConditionWatcher{interval: interval, readings: map[string]*reading{}}
+}
+
+// WithConditionWatcher returns a context in which the conditions waited for by
+// WaitSynced, WaitPeers and WaitFinalizedEpoch are checked using the provided
+// ConditionWatcher, rather than by polling the target for each waiter.
+func WithConditionWatcher(ctx context.Context, w *ConditionWatcher) context.Context {
+	return context.WithValue(ctx, conditionWatcherKey, w)
+}
+
+// getConditionWatcher returns the ConditionWatcher in the provided context, if
+// any.
+func getConditionWatcher(ctx context.Context) *ConditionWatcher {
+	w, _ := ctx.Value(conditionWatcherKey).(*ConditionWatcher)
+	return w
+}
+
+// read returns the last value read of the named state, if it was read within
+// the interval, and otherwise reads it with fetch. Waiters which arrive while
+// it is being read share the result.
+func (w *ConditionWatcher) read(ctx context.Context, name string, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	w.mu.Lock()
+	r, ok := w.readings[name]
+	if !ok {
+		r = &reading{}
+		w.readings[name] = r
+	}
+	if inFlight := r.inFlight; inFlight != nil {
+		w.mu.Unlock()
+		select {
+		case <-inFlight:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		return r.value, r.err
+	}
+	if !r.at.IsZero() && time.Since(r.at) < w.interval {
+		defer w.mu.Unlock()
+		return r.value, r.err
+	}
+	inFlight := make(chan struct{})
+	r.inFlight = inFlight
+	w.mu.Unlock()
+
+	value, err := fetch(ctx)
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	r.value, r.err, r.at = value, err, time.Now()
+	r.inFlight = nil
+	close(inFlight)
+	return value, err
+}
+
+// readShared reads the named state with fetch, through the ConditionWatcher in
+// ctx if there is one.
+func readShared(ctx context.Context, name string, fetch func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if w := getConditionWatcher(ctx); w != nil {
+		return w.read(ctx, name, fetch)
+	}
+	return fetch(ctx)
+}
+
+// syncStatus is the head slot and sync distance the target reports.
+type syncStatus struct {
+	headSlot     int
+	syncDistance int
+}
+
+// readSyncDistance returns the sync distance of the target, shared between
+// waiters as readShared does.
+func readSyncDistance(ctx context.Context) (int, error) {
+	status, err := readShared(ctx, "syncing", func(ctx context.Context) (interface{}, error) {
+		headSlot, syncDistance, err := getHeadSlotAndSyncDistance(ctx)
+		return syncStatus{headSlot: headSlot, syncDistance: syncDistance}, err
+	})
+	if err != nil {
+		return 0, err
+	}
+	return status.(syncStatus).syncDistance, nil
+}
+
+// WaitSynced blocks until the target reports a sync distance of zero.
+func WaitSynced(ctx context.Context) error {
+	return poll(ctx, "a sync distance of 0", func() (bool, string, error) {
+		syncDistance, err := readSyncDistance(ctx)
+		if err != nil {
+			return false, "", err
+		}
+		return syncDistance == 0, fmt.Sprintf("a sync distance of %d", syncDistance), nil
+	})
+}
+
+// WaitFinalizedEpoch blocks until the target has finalized epoch, or a later
+// one.
+func WaitFinalizedEpoch(ctx context.Context, epoch int) error {
+	return poll(ctx, fmt.Sprintf("finalized epoch %d", epoch), func() (bool, string, error) {
+		finalized, err := readShared(ctx, "finalized epoch", func(ctx context.Context) (interface{}, error) {
+			return getFinalizedEpoch(ctx)
+		})
+		if err != nil {
+			return false, "", err
+		}
+		return finalized.(int) >= epoch, fmt.Sprintf("finalized epoch %d", finalized), nil
+	})
+}
+
+// WaitPeers blocks until the target is connected to at least count peers.
+func WaitPeers(ctx context.Context, count int) error {
+	return poll(ctx, fmt.Sprintf("%d connected peers", count), func() (bool, string, error) {
+		connected, err := readShared(ctx, "connected peers", func(ctx context.Context) (interface{}, error) {
+			return getConnectedPeers(ctx)
+		})
+		if err != nil {
+			return false, "", err
+		}
+		return connected.(int) >= count, fmt.Sprintf("%d connected peers", connected), nil
+	})
+}
+
+// WaitNextEpoch blocks until the target has synchronized the first slot of
+// the epoch after that of its current head slot.
+func WaitNextEpoch(ctx context.Context) error {
+	clock, err := slotClock(ctx)
+	if err != nil {
+		return err
+	}
+	headSlot, _, err := getHeadSlotAndSyncDistance(ctx)
+	if err != nil {
+		return err
+	}
+
+	return HasSlot(ctx, clock.EpochStart(clock.Epoch(headSlot)+1))
+}
+
+// WaitSlotFraction blocks until the wall clock is the given fraction of the
+// way into a slot, e.g. 1/3 for the time attestations are made. If that point
+// of the current slot has passed, it waits for that point of the next slot.
+func WaitSlotFraction(ctx context.Context, fraction float64) error {
+	clock, err := slotClock(ctx)
+	if err != nil {
+		return err
+	}
+
+	slot := clock.CurrentSlot()
+	at := clock.SlotStart(slot).Add(time.Duration(fraction * float64(clock.SlotDuration)))
+	if time.Now().After(at) {
+		at = at.Add(clock.SlotDuration)
+	}
+
+	select {
+	case <-ctx.Done():
+		return UnmetConditionError{
+			Condition: fmt.Sprintf("%g of the way into a slot", fraction),
+			Current:   fmt.Sprintf("%g of the way into slot %d", float64(time.Since(clock.SlotStart(slot)))/float64(clock.SlotDuration), slot),
+		}
+	case <-time.After(time.Until(at)):
+		return nil
+	}
+}
+
+// getFinalizedEpoch returns the epoch of the target's finalized checkpoint.
+func getFinalizedEpoch(ctx context.Context) (int, error) {
+	response := struct {
+		Data struct {
+			Finalized struct {
+				Epoch string
+			}
+		}
+	}{}
+	if err := oapi.GetJSON(ctx, finalityRoute, nil, &response); err != nil {
+		return 0, BadTargetError{Route: finalityRoute, Err: err}
+	}
+
+	epoch, err := strconv.ParseInt(response.Data.Finalized.Epoch, 10, 0)
+	if err != nil {
+		return 0, BadTargetError{Route: finalityRoute, Err: err}
+	}
+	return int(epoch), nil
+}
+
+// getConnectedPeers returns the number of peers the target is connected to.
+func getConnectedPeers(ctx context.Context) (int, error) {
+	response := struct {
+		Data []struct {
+			State string
+		}
+	}{}
+	if err := oapi.GetJSON(ctx, peersRoute, nil, &response); err != nil {
+		return 0, BadTargetError{Route: peersRoute, Err: err}
+	}
+
+	connected := 0
+	for _, peer := range response.Data {
+		if peer.State == "connected" {
+			connected++
+		}
+	}
+	return connected, nil
+}
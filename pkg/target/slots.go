@@ -0,0 +1,157 @@
+package target
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type contextKey int
+
+const slotWatcherKey contextKey = 0
+
+// SlotWatcher polls the head slot of the target on behalf of any number of
+// waiters, and releases each of them once the target reaches their slot. It
+// only polls the target while something is waiting, as soon as a waiter
+// arrives, and when asked to refresh the head it has seen.
+type SlotWatcher struct {
+	interval time.Duration
+	// wake asks Run to poll the target without waiting for the next tick.
+	wake chan struct{}
+
+	mu      sync.Mutex
+	head    int
+	known   bool
+	waiters []slotWaiter
+}
+
+type slotWaiter struct {
+	slot     int
+	released chan struct{}
+}
+
+// NewSlotWatcher returns a SlotWatcher which polls the target once per
+// interval. It does nothing until Run is called.
+func NewSlotWatcher(interval time.Duration) *SlotWatcher {
+	return &SlotWatcher{interval: interval, wake: make(chan struct{}, 1)}
+}
+
+// WithSlotWatcher returns a context in which HasSlot waits using the provided
+// SlotWatcher, rather than polling the target itself.
+func WithSlotWatcher(ctx context.Context, w *SlotWatcher) context.Context {
+	return context.WithValue(ctx, slotWatcherKey, w)
+}
+
+// getSlotWatcher returns the SlotWatcher in the provided context, if any.
+func getSlotWatcher(ctx context.Context) *SlotWatcher {
+	w, _ := ctx.Value(slotWatcherKey).(*SlotWatcher)
+	return w
+}
+
+// Run polls the target until ctx is done. ctx must carry an OAPI client for
+// the target.
+func (w *SlotWatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	woken := false
+	for {
+		if woken || w.waiting() {
+			// Errors are transient as far as waiters are concerned: they
+			// keep waiting until the next poll or their own deadline.
+			if headSlot, _, err := getHeadSlotAndSyncDistance(ctx); err == nil {
+				w.setHead(headSlot)
+			}
+		}
+
+		woken = false
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-w.wake:
+			woken = true
+		}
+	}
+}
+
+// Wait blocks until the target has synchronized slot, or ctx is done. In the
+// latter case it returns a *ClientMissingTargetSlotErr with the last head slot
+// seen, or a *TargetLaggingErr if ctx carries a SlotClock by which the slot
+// has begun.
+func (w *SlotWatcher) Wait(ctx context.Context, slot int) error {
+	w.mu.Lock()
+	if w.head >= slot {
+		w.mu.Unlock()
+		return nil
+	}
+	waiter := slotWaiter{slot: slot, released: make(chan struct{})}
+	w.waiters = append(w.waiters, waiter)
+	w.mu.Unlock()
+
+	// The last head seen may be stale, so poll now rather than making the
+	// waiter wait a tick for a slot the target may already have.
+	w.refresh()
+
+	select {
+	case <-waiter.released:
+		return nil
+	case <-ctx.Done():
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		w.remove(waiter)
+		return explainMissingSlot(ctx, &ClientMissingTargetSlotErr{Current: w.head, Target: slot, CurrentUnknown: !w.known})
+	}
+}
+
+// refresh asks Run to poll the target now, without waiting for the next tick
+// or for anything to wait.
+func (w *SlotWatcher) refresh() {
+	select {
+	case w.wake <- struct{}{}:
+	default:
+	}
+}
+
+// latest returns the last head slot seen, and whether any has been seen.
+func (w *SlotWatcher) latest() (int, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.head, w.known
+}
+
+// waiting reports whether anything is waiting for a slot.
+func (w *SlotWatcher) waiting() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.waiters) > 0
+}
+
+// setHead records the head slot of the target and releases the waiters whose
+// slots it has reached.
+func (w *SlotWatcher) setHead(headSlot int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.head = headSlot
+	w.known = true
+	remaining := w.waiters[:0]
+	for _, waiter := range w.waiters {
+		if waiter.slot <= headSlot {
+			close(waiter.released)
+			continue
+		}
+		remaining = append(remaining, waiter)
+	}
+	w.waiters = remaining
+}
+
+// remove drops a waiter which is no longer waiting. The caller must hold mu.
+func (w *SlotWatcher) remove(waiter slotWaiter) {
+	for i, other := range w.waiters {
+		if other.released == waiter.released {
+			w.waiters = append(w.waiters[:i], w.waiters[i+1:]...)
+			return
+		}
+	}
+}
@@ -45,15 +45,33 @@ func IsHealthy(ctx context.Context) error {
 type ClientMissingTargetSlotErr struct {
 	Current int
 	Target  int
+	// CurrentUnknown is true if the head slot of the target could not be
+	// read, in which case Current is meaningless.
+	CurrentUnknown bool
 }
 
 func (e *ClientMissingTargetSlotErr) Error() string {
+	if e.CurrentUnknown {
+		return fmt.Sprintf("Target's head slot is unknown. Needs slot %d.", e.Target)
+	}
 	return fmt.Sprintf("Target is at slot %d. Needs slot %d.", e.Current, e.Target)
 }
 
 // HasSlot blocks until the target server has synchronized the slot needed for
-// the test case.
+// the test case. If ctx carries a SlotWatcher, HasSlot waits using it instead
+// of polling the target itself. If ctx carries a SlotClock, HasSlot does not
+// poll the target before the slot begins, and it returns a *TargetLaggingErr
+// rather than a *ClientMissingTargetSlotErr if it gives up after the slot has
+// begun.
 func HasSlot(ctx context.Context, awaitSlot int) error {
+	if err := waitForSlotStart(ctx, awaitSlot); err != nil {
+		return err
+	}
+
+	if w := getSlotWatcher(ctx); w != nil {
+		return w.Wait(ctx, awaitSlot)
+	}
+
 	var defaultRetryInterval time.Duration = time.Second
 	var defaultRetryAttempts uint
 
@@ -84,6 +102,9 @@ func HasSlot(ctx context.Context, awaitSlot int) error {
 		retry.DelayType(retry.FixedDelay),
 		retry.LastErrorOnly(true),
 	); err != nil {
+		if missing, ok := err.(*ClientMissingTargetSlotErr); ok {
+			return explainMissingSlot(ctx, missing)
+		}
 		return err
 	}
 
@@ -99,14 +120,37 @@ func getHeadSlotAndSyncDistance(ctx context.Context) (int, int, error) {
 		return 0, 0, BadTargetError{Route: "/eth/v1/node/syncing", Err: err}
 	}
 
-	headSlot, err := strconv.ParseInt(result.Data.HeadSlot.(string), 10, 0)
+	// The generated data structure leaves the fields untyped, and a target
+	// may send them as numbers rather than decimal strings.
+	headSlotString, ok := result.Data.HeadSlot.(string)
+	if !ok {
+		return 0, 0, BadTargetError{Route: "/eth/v1/node/syncing", Err: fmt.Errorf("head_slot %v is not a decimal string", result.Data.HeadSlot)}
+	}
+	syncDistanceString, ok := result.Data.SyncDistance.(string)
+	if !ok {
+		return 0, 0, BadTargetError{Route: "/eth/v1/node/syncing", Err: fmt.Errorf("sync_distance %v is not a decimal string", result.Data.SyncDistance)}
+	}
+
+	headSlot, err := strconv.ParseInt(headSlotString, 10, 0)
 	if err != nil {
 		return 0, 0, err
 	}
-	syncDistance, err := strconv.ParseInt(result.Data.SyncDistance.(string), 10, 0)
+	syncDistance, err := strconv.ParseInt(syncDistanceString, 10, 0)
 	if err != nil {
 		return 0, 0, err
 	}
 
 	return int(headSlot), int(syncDistance), nil
 }
+
+// NodeVersion returns the version string the target reports for itself, e.g.
+// "Lighthouse/v0.1.5 (Linux x86_64)".
+func NodeVersion(ctx context.Context) (string, error) {
+	client := oapi.GetClient(ctx)
+	result, _, err := client.NodeApi.GetNodeVersion(ctx)
+	if err != nil {
+		return "", BadTargetError{Route: "/eth/v1/node/version", Err: err}
+	}
+
+	return result.Data.Version, nil
+}
@@ -0,0 +1,232 @@
+package target
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+// Routes the state of the target is read from.
+const (
+	headerRoute     = "/eth/v1/beacon/headers/head"
+	finalityRoute   = "/eth/v1/beacon/states/head/finality_checkpoints"
+	genesisRoute    = "/eth/v1/beacon/genesis"
+	specRoute       = "/eth/v1/config/spec"
+	validatorsRoute = "/eth/v1/beacon/states/head/validators"
+	peersRoute      = "/eth/v1/node/peers"
+)
+
+// builtins maps the names of the built-in variables to functions resolving
+// them.
+var builtins = map[string]func(ctx context.Context, r *VariableResolver) (string, error){
+	"head_slot": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, headerRoute, nil, "header.message.slot")
+	},
+	"head_root": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, headerRoute, nil, "root")
+	},
+	"previous_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.epoch(ctx, -1)
+	},
+	"current_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.epoch(ctx, 0)
+	},
+	"next_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.epoch(ctx, 1)
+	},
+	"finalized_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "finalized.epoch")
+	},
+	"finalized_root": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "finalized.root")
+	},
+	"justified_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "current_justified.epoch")
+	},
+	"justified_root": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "current_justified.root")
+	},
+	"previous_justified_epoch": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "previous_justified.epoch")
+	},
+	"previous_justified_root": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, finalityRoute, nil, "previous_justified.root")
+	},
+	"genesis_time": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, genesisRoute, nil, "genesis_time")
+	},
+	"genesis_validators_root": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, genesisRoute, nil, "genesis_validators_root")
+	},
+	"genesis_fork_version": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.field(ctx, genesisRoute, nil, "genesis_fork_version")
+	},
+	"active_validator_index": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.activeValidator(ctx, "index")
+	},
+	"active_validator_pubkey": func(ctx context.Context, r *VariableResolver) (string, error) {
+		return r.activeValidator(ctx, "validator.pubkey")
+	},
+}
+
+// Variables returns the names of the built-in variables, sorted.
+func Variables() []string {
+	names := make([]string, 0, len(builtins))
+	for name := range builtins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// VariableResolver resolves built-in variables, which describe the current
+// state of the target's chain, e.g. its head slot or finalized epoch. It reads
+// each route it needs from the target at most once, so that the values it
+// resolves are consistent with each other. It should be discarded once they
+// may be stale.
+type VariableResolver struct {
+	mu        sync.Mutex
+	responses map[string]interface{}
+	validator interface{}
+	rand      *rand.Rand
+}
+
+func NewVariableResolver() *VariableResolver {
+	return &VariableResolver{
+		responses: map[string]interface{}{},
+		rand:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Resolve returns the value of the named built-in variable for the target in
+// ctx. The boolean result is false if there is no such built-in variable.
+func (r *VariableResolver) Resolve(ctx context.Context, name string) (string, bool, error) {
+	resolve, ok := builtins[name]
+	if !ok {
+		return "", false, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	value, err := resolve(ctx, r)
+	return value, true, err
+}
+
+// data returns the data property of the target's response for route.
+func (r *VariableResolver) data(ctx context.Context, route string, queryParams map[string]string) (interface{}, error) {
+	names := make([]string, 0, len(queryParams))
+	for name := range queryParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	key := route
+	for _, name := range names {
+		key = fmt.Sprintf("%s&%s=%s", key, name, queryParams[name])
+	}
+	if data, ok := r.responses[key]; ok {
+		return data, nil
+	}
+
+	response := struct {
+		Data interface{}
+	}{}
+	if err := oapi.GetJSON(ctx, route, queryParams, &response); err != nil {
+		return nil, BadTargetError{Route: route, Err: err}
+	}
+	r.responses[key] = response.Data
+
+	return response.Data, nil
+}
+
+// field returns the string at a dotted path, like "header.message.slot", in
+// the data property of the target's response for route.
+func (r *VariableResolver) field(ctx context.Context, route string, queryParams map[string]string, path string) (string, error) {
+	data, err := r.data(ctx, route, queryParams)
+	if err != nil {
+		return "", err
+	}
+
+	value, ok := lookup(data, path)
+	if !ok {
+		return "", BadTargetError{Route: route, Err: fmt.Errorf("the response has no string at data.%s", path)}
+	}
+	return value, nil
+}
+
+// epoch returns the epoch of the target's head slot, plus offset. The epoch
+// before the first is the first.
+func (r *VariableResolver) epoch(ctx context.Context, offset int) (string, error) {
+	slotValue, err := r.field(ctx, headerRoute, nil, "header.message.slot")
+	if err != nil {
+		return "", err
+	}
+	slot, err := strconv.ParseUint(slotValue, 10, 64)
+	if err != nil {
+		return "", BadTargetError{Route: headerRoute, Err: err}
+	}
+
+	slotsPerEpochValue, err := r.field(ctx, specRoute, nil, "SLOTS_PER_EPOCH")
+	if err != nil {
+		return "", err
+	}
+	slotsPerEpoch, err := strconv.ParseUint(slotsPerEpochValue, 10, 64)
+	if err != nil || slotsPerEpoch == 0 {
+		return "", BadTargetError{Route: specRoute, Err: fmt.Errorf("invalid SLOTS_PER_EPOCH %q", slotsPerEpochValue)}
+	}
+
+	epoch := slot / slotsPerEpoch
+	switch {
+	case offset > 0:
+		epoch += uint64(offset)
+	case offset < 0 && epoch >= uint64(-offset):
+		epoch -= uint64(-offset)
+	case offset < 0:
+		epoch = 0
+	}
+	return strconv.FormatUint(epoch, 10), nil
+}
+
+// activeValidator returns the string at a dotted path in a random active
+// validator. The same validator is used for every path.
+func (r *VariableResolver) activeValidator(ctx context.Context, path string) (string, error) {
+	queryParams := map[string]string{"status": "active"}
+	if r.validator == nil {
+		data, err := r.data(ctx, validatorsRoute, queryParams)
+		if err != nil {
+			return "", err
+		}
+		validators, _ := data.([]interface{})
+		if len(validators) == 0 {
+			return "", BadTargetError{Route: validatorsRoute, Err: fmt.Errorf("there are no active validators")}
+		}
+		r.validator = validators[r.rand.Intn(len(validators))]
+	}
+
+	value, ok := lookup(r.validator, path)
+	if !ok {
+		return "", BadTargetError{Route: validatorsRoute, Err: fmt.Errorf("the validator has no string at %s", path)}
+	}
+	return value, nil
+}
+
+// lookup returns the string at a dotted path in a value decoded into generic
+// JSON types.
+func lookup(v interface{}, path string) (string, bool) {
+	for _, key := range strings.Split(path, ".") {
+		object, ok := v.(map[string]interface{})
+		if !ok {
+			return "", false
+		}
+		v = object[key]
+	}
+	s, ok := v.(string)
+	return s, ok
+}
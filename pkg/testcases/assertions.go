@@ -0,0 +1,272 @@
+package testcases
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+// Ways of comparing an ExpectedRespBody to the response body.
+const (
+	// BodyMatchExact requires the response body to equal the expected body.
+	BodyMatchExact = "exact"
+	// BodyMatchSubset requires the response body to contain the expected
+	// body. Objects may have properties which are not expected, and arrays
+	// may have elements which are not expected between the expected ones.
+	BodyMatchSubset = "subset"
+)
+
+// Assertion is a check on the values a JSONPath selects from the response
+// body. Every selected value must pass every check which is set, and a path
+// which selects nothing fails.
+type Assertion struct {
+	// Path is a JSONPath selector, e.g. "$.data[*].status".
+	Path string
+	// Equals is the value the selected values must be equal to.
+	Equals interface{}
+	// Regex is a regular expression the selected values must be strings
+	// matching.
+	Regex string
+	// Min and Max are inclusive bounds for selected values, which must be
+	// numbers or decimal strings like the spec's Uint64. The bounds
+	// themselves may be given as numbers or decimal strings.
+	Min interface{}
+	Max interface{}
+	// MinLength and MaxLength are inclusive bounds for the length of
+	// selected arrays.
+	MinLength *int
+	MaxLength *int
+}
+
+type AssertionError struct {
+	Path     string
+	Failures []string
+}
+
+func (e AssertionError) Error() string {
+	return fmt.Sprintf("Response body does not satisfy the assertions for %s:\n%s", e.Path, strings.Join(e.Failures, "\n"))
+}
+
+// validate checks that the assertion is well-formed.
+func (a Assertion) validate() error {
+	if _, err := parseJSONPath(a.Path); err != nil {
+		return err
+	}
+	if a.Regex != "" {
+		if _, err := regexp.Compile(a.Regex); err != nil {
+			return err
+		}
+	}
+	for _, bound := range []interface{}{a.Min, a.Max} {
+		// Variables are only known when the assertion is checked.
+		if bound == nil || isVariable(bound) {
+			continue
+		}
+		if _, ok := decimal(bound); !ok {
+			return fmt.Errorf("Bound %v of the assertion for %s is not a number", bound, a.Path)
+		}
+	}
+	return nil
+}
+
+// check evaluates the assertion against a response body, decoded into
+// generic JSON types.
+func (a Assertion) check(body interface{}) error {
+	path, err := parseJSONPath(a.Path)
+	if err != nil {
+		return err
+	}
+
+	// The regex may have had variables substituted into it since it was
+	// validated, so it is compiled again here.
+	var regex *regexp.Regexp
+	if a.Regex != "" {
+		if regex, err = regexp.Compile(a.Regex); err != nil {
+			return fmt.Errorf("Regex %s of the assertion for %s is invalid: %s", a.Regex, a.Path, err.Error())
+		}
+	}
+
+	nodes := path.selectNodes(body)
+	if len(nodes) == 0 {
+		return AssertionError{Path: a.Path, Failures: []string{"the path selects nothing"}}
+	}
+
+	failures := []string{}
+	for _, node := range nodes {
+		for _, failure := range a.checkValue(node.value, regex) {
+			failures = append(failures, fmt.Sprintf("%s: %s", describeValue(node.value), failure))
+		}
+	}
+	if len(failures) > 0 {
+		return AssertionError{Path: a.Path, Failures: failures}
+	}
+
+	return nil
+}
+
+// checkValue returns a description of every check the value fails. regex is
+// the compiled Regex, if any.
+func (a Assertion) checkValue(value interface{}, regex *regexp.Regexp) []string {
+	failures := []string{}
+
+	if a.Equals != nil {
+		expected, err := normalizeJSON(a.Equals)
+		if err != nil || !reflect.DeepEqual(expected, value) {
+			failures = append(failures, fmt.Sprintf("is not equal to %s", describeValue(a.Equals)))
+		}
+	}
+
+	if regex != nil {
+		s, ok := value.(string)
+		if !ok || !regex.MatchString(s) {
+			failures = append(failures, fmt.Sprintf("does not match %s", a.Regex))
+		}
+	}
+
+	if a.Min != nil || a.Max != nil {
+		n, ok := decimal(value)
+		if !ok {
+			failures = append(failures, "is not a number")
+		} else {
+			if min, ok := decimal(a.Min); ok && n.Cmp(min) < 0 {
+				failures = append(failures, fmt.Sprintf("is less than %v", a.Min))
+			}
+			if max, ok := decimal(a.Max); ok && n.Cmp(max) > 0 {
+				failures = append(failures, fmt.Sprintf("is greater than %v", a.Max))
+			}
+		}
+	}
+
+	if a.MinLength != nil || a.MaxLength != nil {
+		list, ok := value.([]interface{})
+		if !ok {
+			failures = append(failures, "is not an array")
+		} else {
+			if a.MinLength != nil && len(list) < *a.MinLength {
+				failures = append(failures, fmt.Sprintf("has fewer than %d elements", *a.MinLength))
+			}
+			if a.MaxLength != nil && len(list) > *a.MaxLength {
+				failures = append(failures, fmt.Sprintf("has more than %d elements", *a.MaxLength))
+			}
+		}
+	}
+
+	return failures
+}
+
+// decimal converts a JSON number or decimal string to an exact number, so that
+// values as large as the spec's Uint64 compare correctly.
+func decimal(v interface{}) (*big.Rat, bool) {
+	switch v := v.(type) {
+	case string:
+		return new(big.Rat).SetString(strings.TrimSpace(v))
+	case float64:
+		return new(big.Rat).SetString(strconv.FormatFloat(v, 'f', -1, 64))
+	case int:
+		return new(big.Rat).SetInt64(int64(v)), true
+	case json.Number:
+		return new(big.Rat).SetString(v.String())
+	}
+	return nil, false
+}
+
+// normalizeJSON converts a value to the generic types encoding/json decodes
+// into.
+func normalizeJSON(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err = json.Unmarshal(data, &normalized)
+	return normalized, err
+}
+
+// actualBody returns the body of the response to assert on, decoded into
+// generic JSON types. This is the raw body received, when it is JSON, so that
+// assertions see values exactly as the target sent them. Otherwise, as for
+// event streams, it is the canonical form of the Response.
+func actualBody(result *oapi.ExecutorResult) (interface{}, error) {
+	if len(result.Body) > 0 && result.Header != nil && strings.Contains(strings.ToLower(result.Header.Get("Content-Type")), "json") {
+		var body interface{}
+		if err := json.Unmarshal(result.Body, &body); err == nil {
+			return body, nil
+		}
+	}
+	return canonicalize(result)
+}
+
+// ignorePaths removes the values selected by each path from a document.
+func ignorePaths(document interface{}, paths []string) error {
+	for _, path := range paths {
+		parsed, err := parseJSONPath(path)
+		if err != nil {
+			return err
+		}
+		for _, node := range parsed.selectNodes(document) {
+			node.remove()
+		}
+	}
+	return nil
+}
+
+// diffSubset appends a Difference to differences for every value in expected
+// which received does not contain, and returns the result. The elements of an
+// expected array must be contained by elements of the received array in the
+// same order, though not necessarily consecutively.
+func diffSubset(pointer string, expected, received interface{}, differences []Difference) []Difference {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		received, ok := received.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for _, key := range sortedKeys(expected) {
+			differences = diffSubset(pointer+"/"+apispec.EscapePointer(key), expected[key], received[key], differences)
+		}
+		return differences
+
+	case []interface{}:
+		received, ok := received.([]interface{})
+		if !ok {
+			break
+		}
+		next := 0
+		for i, expectedItem := range expected {
+			found := false
+			for j := next; j < len(received); j++ {
+				if len(diffSubset("", expectedItem, received[j], nil)) == 0 {
+					found = true
+					next = j + 1
+					break
+				}
+			}
+			if !found {
+				differences = append(differences, Difference{Pointer: pointer + "/" + strconv.Itoa(i), Expected: expectedItem, Received: nil})
+			}
+		}
+		return differences
+	}
+
+	if !reflect.DeepEqual(expected, received) {
+		differences = append(differences, Difference{Pointer: pointer, Expected: expected, Received: received})
+	}
+	return differences
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,90 @@
+package testcases
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/INFURA/eth2-comply/pkg/target"
+)
+
+// AwaitConfig describes conditions on the state of the target to wait for
+// before executing a case, after its AwaitSlot. The conditions are waited for
+// in the order of the fields.
+type AwaitConfig struct {
+	// Synced waits for the target to report a sync distance of zero.
+	Synced bool
+	// Peers waits for the target to be connected to at least this many
+	// peers.
+	Peers int
+	// FinalizedEpoch waits for the target to finalize this epoch, or a later
+	// one.
+	FinalizedEpoch int
+	// NextEpoch waits for the target to sync the first slot of the epoch
+	// after that of its head slot.
+	NextEpoch bool
+	// SlotFraction waits until the wall clock is this fraction of the way
+	// into a slot, e.g. 0.34 for just after attestations are due.
+	SlotFraction *float64
+}
+
+// validate checks that the AwaitConfig is well-formed.
+func (a AwaitConfig) validate() error {
+	if a.Peers < 0 {
+		return fmt.Errorf("await.peers must not be negative")
+	}
+	if a.FinalizedEpoch < 0 {
+		return fmt.Errorf("await.finalizedEpoch must not be negative")
+	}
+	if a.SlotFraction != nil && (*a.SlotFraction < 0 || *a.SlotFraction >= 1) {
+		return fmt.Errorf("await.slotFraction must be at least 0 and less than 1, not %g", *a.SlotFraction)
+	}
+	return nil
+}
+
+// await waits for the Case's await slot and any other conditions it awaits.
+// If waiting fails, it also returns a description of what it was waiting for.
+func (c Case) await(ctx context.Context) (string, error) {
+	if c.Config.AwaitSlot > 0 {
+		if err := target.HasSlot(ctx, c.Config.AwaitSlot); err != nil {
+			return fmt.Sprintf("waiting for slot %d", c.Config.AwaitSlot), err
+		}
+	}
+
+	a := c.Config.Await
+	if a == nil {
+		return "", nil
+	}
+
+	if a.Synced {
+		if err := target.WaitSynced(ctx); err != nil {
+			return "waiting for the target to sync", err
+		}
+	}
+	if a.Peers > 0 {
+		if err := target.WaitPeers(ctx, a.Peers); err != nil {
+			return fmt.Sprintf("waiting for %d peers", a.Peers), err
+		}
+	}
+	if a.FinalizedEpoch > 0 {
+		if err := target.WaitFinalizedEpoch(ctx, a.FinalizedEpoch); err != nil {
+			return fmt.Sprintf("waiting for finalized epoch %d", a.FinalizedEpoch), err
+		}
+	}
+	if a.NextEpoch {
+		if err := target.WaitNextEpoch(ctx); err != nil {
+			return "waiting for the next epoch", err
+		}
+	}
+	if a.SlotFraction != nil {
+		if err := target.WaitSlotFraction(ctx, *a.SlotFraction); err != nil {
+			return fmt.Sprintf("waiting for %g of the way into a slot", *a.SlotFraction), err
+		}
+	}
+
+	return "", nil
+}
+
+// awaits reports whether the Case waits for anything before it is executed.
+func (c Case) awaits() bool {
+	return c.Config.AwaitSlot > 0 || c.Config.Await != nil
+}
@@ -0,0 +1,154 @@
+package testcases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+// Difference is a value on which the responses of two targets disagree.
+type Difference struct {
+	// Pointer is the JSON pointer (RFC 6901) of the value in the response
+	// bodies. The root of the body is "", and a difference in status codes
+	// has the pointer "(status code)".
+	Pointer string
+	// Expected is the value in the response of the primary target, and
+	// Received the value in the response of the compared target. Values
+	// missing from a response are nil.
+	Expected interface{}
+	Received interface{}
+}
+
+func (d Difference) String() string {
+	pointer := d.Pointer
+	if pointer == "" {
+		pointer = "/"
+	}
+	return fmt.Sprintf("%s: %s != %s", pointer, describeValue(d.Expected), describeValue(d.Received))
+}
+
+type DifferenceError struct {
+	Target      string
+	Reference   string
+	Differences []Difference
+}
+
+func (e DifferenceError) Error() string {
+	lines := make([]string, len(e.Differences))
+	for i, difference := range e.Differences {
+		lines[i] = difference.String()
+	}
+	return fmt.Sprintf("Response from %s differs from the response from %s:\n%s", e.Target, e.Reference, strings.Join(lines, "\n"))
+}
+
+// compareTargets executes the Case's operation against each of the Case's
+// CompareTargets, and checks that their responses agree with the result from
+// the primary target. Responses are compared in the same canonical form that
+// assertExpectations uses.
+func (c Case) compareTargets(ctx context.Context, result *oapi.ExecutorResult) error {
+	reference := oapi.GetClient(ctx).GetConfig().BasePath
+
+	expected, err := canonicalize(result)
+	if err != nil {
+		return err
+	}
+
+	for _, compareTarget := range c.CompareTargets {
+		targetCtx := oapi.WithClient(ctx, compareTarget)
+		other, err := c.execOperation(targetCtx)
+		if other == nil {
+			if err == nil {
+				err = fmt.Errorf("no response")
+			}
+			return DifferenceError{
+				Target:      compareTarget.String(),
+				Reference:   reference,
+				Differences: []Difference{{Expected: "a response", Received: err.Error()}},
+			}
+		}
+
+		differences := []Difference{}
+		if *other.StatusCode != *result.StatusCode {
+			differences = append(differences, Difference{Pointer: "(status code)", Expected: *result.StatusCode, Received: *other.StatusCode})
+		}
+
+		received, err := canonicalize(other)
+		if err != nil {
+			return err
+		}
+		differences = diffJSON("", expected, received, differences)
+
+		if len(differences) > 0 {
+			return DifferenceError{Target: compareTarget.String(), Reference: reference, Differences: differences}
+		}
+	}
+
+	return nil
+}
+
+// diffJSON appends a Difference to differences for every value in which
+// expected and received disagree, and returns the result.
+func diffJSON(pointer string, expected, received interface{}, differences []Difference) []Difference {
+	switch expected := expected.(type) {
+	case map[string]interface{}:
+		received, ok := received.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := []string{}
+		for key := range expected {
+			keys = append(keys, key)
+		}
+		for key := range received {
+			if _, ok := expected[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			differences = diffJSON(pointer+"/"+apispec.EscapePointer(key), expected[key], received[key], differences)
+		}
+		return differences
+
+	case []interface{}:
+		received, ok := received.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(expected) || i < len(received); i++ {
+			var expectedItem, receivedItem interface{}
+			if i < len(expected) {
+				expectedItem = expected[i]
+			}
+			if i < len(received) {
+				receivedItem = received[i]
+			}
+			differences = diffJSON(pointer+"/"+strconv.Itoa(i), expectedItem, receivedItem, differences)
+		}
+		return differences
+	}
+
+	if !reflect.DeepEqual(expected, received) {
+		differences = append(differences, Difference{Pointer: pointer, Expected: expected, Received: received})
+	}
+	return differences
+}
+
+// describeValue formats a JSON value for a Difference.
+func describeValue(v interface{}) string {
+	if v == nil {
+		return "(missing)"
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -0,0 +1,596 @@
+package testcases_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/INFURA/eth2-comply/pkg/apispec"
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/selftest"
+	"github.com/INFURA/eth2-comply/pkg/target"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// headSlot is the slot the mock's head is in when the tests start.
+const headSlot = 100
+
+func newServer(t *testing.T) *selftest.Server {
+	t.Helper()
+	s, err := selftest.NewServer(mock.Opts{
+		Genesis: time.Now().Add(-headSlot * 12 * time.Second),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(s.Close)
+	return s
+}
+
+// sleep returns a handler which answers like the Node after a delay.
+func sleep(s *selftest.Server, d time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(d)
+		s.Node.ServeHTTP(w, r)
+	})
+}
+
+func TestExec(t *testing.T) {
+	tests := []struct {
+		name string
+		// setup changes the server's behavior before the case is executed.
+		setup  func(s *selftest.Server)
+		config testcases.CaseConfig
+		// err is a value of the type of error expected, or nil if the case
+		// should pass.
+		err      error
+		timedOut bool
+	}{
+		{
+			name: "passing case",
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/beacon/genesis",
+				ExpectedRespStatus: 200,
+			},
+		},
+		{
+			name: "status mismatch",
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/node/version",
+				ExpectedRespStatus: 404,
+			},
+			err: testcases.StatusMismatchError{},
+		},
+		{
+			name: "expected error status",
+			config: testcases.CaseConfig{
+				Method: "POST",
+				Route:  "/eth/v1/beacon/pool/voluntary_exits",
+				ReqBody: map[string]interface{}{
+					"message":   map[string]interface{}{"epoch": "1", "validator_index": "1"},
+					"signature": "0x" + strings.Repeat("1b", 96),
+				},
+				ExpectedRespStatus: 400,
+			},
+		},
+		{
+			name: "unexpected error status",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/node/version", http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal error"})
+			},
+			config: testcases.CaseConfig{
+				Method: "GET",
+				Route:  "/eth/v1/node/version",
+			},
+			err: testcases.OapiError{},
+		},
+		{
+			name: "malformed body with the expected status",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/beacon/genesis", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"genesis_time": 5, "genesis_validators_root": 7, "genesis_fork_version": 1}})
+			},
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/beacon/genesis",
+				ExpectedRespStatus: 200,
+			},
+			err: testcases.OapiError{},
+		},
+		{
+			name: "unsupported operation",
+			config: testcases.CaseConfig{
+				Method: "GET",
+				Route:  "/eth/v1/not/an/operation",
+			},
+			err: testcases.UnimplementedOperationError{},
+		},
+		{
+			name: "route with path params",
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/beacon/states/head/fork",
+				ExpectedRespStatus: 200,
+				ExpectedRespBody: map[string]interface{}{
+					"data": map[string]interface{}{
+						"previous_version": "0x00000000",
+						"current_version":  "0x00000000",
+						"epoch":            "0",
+					},
+				},
+			},
+		},
+		{
+			name: "exact body mismatch",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/node/version",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"version": "other/v1.0.0"}},
+			},
+			err: testcases.BodyMismatchError{},
+		},
+		{
+			name: "exact body match ignoring paths",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/node/version",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"version": "other/v1.0.0"}},
+				IgnorePaths:      []string{"$.data.version"},
+			},
+		},
+		{
+			name: "subset body match",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/beacon/genesis",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"genesis_fork_version": "0x00000000"}},
+				BodyMatch:        testcases.BodyMatchSubset,
+			},
+		},
+		{
+			name: "subset body mismatch",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/beacon/genesis",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"genesis_fork_version": "0x01000000"}},
+				BodyMatch:        testcases.BodyMatchSubset,
+			},
+			err: testcases.BodyMismatchError{},
+		},
+		{
+			name: "subset body with a misspelled key",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/beacon/genesis",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"genesis_fork_versoin": "0x99999999"}},
+				BodyMatch:        testcases.BodyMatchSubset,
+			},
+			err: testcases.BodyMismatchError{},
+		},
+		{
+			name: "subset body with a missing key",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/beacon/genesis",
+				ExpectedRespBody: map[string]interface{}{"dat": 1},
+				BodyMatch:        testcases.BodyMatchSubset,
+			},
+			err: testcases.BodyMismatchError{},
+		},
+		{
+			name: "exact body with a missing key ignoring paths",
+			config: testcases.CaseConfig{
+				Method:           "GET",
+				Route:            "/eth/v1/node/version",
+				ExpectedRespBody: map[string]interface{}{"data": map[string]interface{}{"version": "other/v1.0.0", "build": "1"}},
+				IgnorePaths:      []string{"$.data.version"},
+			},
+			err: testcases.BodyMismatchError{},
+		},
+		{
+			name: "failed assertion",
+			config: testcases.CaseConfig{
+				Method:     "GET",
+				Route:      "/eth/v1/node/syncing",
+				Assertions: []testcases.Assertion{{Path: "$.data.head_slot", Max: "1"}},
+			},
+			err: testcases.AssertionError{},
+		},
+		{
+			name: "header mismatch",
+			config: testcases.CaseConfig{
+				Method:              "GET",
+				Route:               "/eth/v1/node/version",
+				ExpectedRespHeaders: map[string]testcases.HeaderExpectation{"Content-Type": {Equals: "text/plain"}},
+			},
+			err: testcases.HeaderMismatchError{},
+		},
+		{
+			name: "unknown field is lenient",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/node/version", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": "v1", "extra": 1}})
+			},
+			config: testcases.CaseConfig{
+				Method: "GET",
+				Route:  "/eth/v1/node/version",
+			},
+		},
+		{
+			name: "unknown field is strict",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/node/version", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": "v1", "extra": 1}})
+			},
+			config: testcases.CaseConfig{
+				Method: "GET",
+				Route:  "/eth/v1/node/version",
+				Strict: true,
+			},
+			err: apispec.SchemaError{},
+		},
+		{
+			name: "expected error status is lenient",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/node/version", http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal error", "extra": 1})
+			},
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/node/version",
+				ExpectedRespStatus: 500,
+			},
+		},
+		{
+			name: "expected error status is strict",
+			setup: func(s *selftest.Server) {
+				s.Respond("GET", "/eth/v1/node/version", http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal error", "extra": 1})
+			},
+			config: testcases.CaseConfig{
+				Method:             "GET",
+				Route:              "/eth/v1/node/version",
+				ExpectedRespStatus: 500,
+				Strict:             true,
+			},
+			err: apispec.SchemaError{},
+		},
+		{
+			name: "request timeout",
+			setup: func(s *selftest.Server) {
+				s.Handle("GET", "/eth/v1/node/version", sleep(s, 500*time.Millisecond))
+			},
+			config: testcases.CaseConfig{
+				Method:  "GET",
+				Route:   "/eth/v1/node/version",
+				Timeout: "50ms",
+			},
+			err:      testcases.TimeoutError{},
+			timedOut: true,
+		},
+		{
+			name: "maximum latency exceeded",
+			setup: func(s *selftest.Server) {
+				s.Handle("GET", "/eth/v1/node/version", sleep(s, 50*time.Millisecond))
+			},
+			config: testcases.CaseConfig{
+				Method:     "GET",
+				Route:      "/eth/v1/node/version",
+				MaxLatency: "10ms",
+			},
+			err: testcases.LatencyError{},
+		},
+		{
+			name: "synced await slot",
+			config: testcases.CaseConfig{
+				Method:    "GET",
+				Route:     "/eth/v1/beacon/headers/head",
+				AwaitSlot: headSlot,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			s := newServer(t)
+			if test.setup != nil {
+				test.setup(s)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			c := s.Exec(ctx, test.config)
+
+			if test.err == nil {
+				if !c.Result.Success {
+					t.Fatalf("expected the case to pass, got: %s", c.Result.Error)
+				}
+				return
+			}
+			if c.Result.Success {
+				t.Fatalf("expected the case to fail with a %T", test.err)
+			}
+			if reflect.TypeOf(c.Result.Error) != reflect.TypeOf(test.err) {
+				t.Fatalf("expected a %T, got a %T: %s", test.err, c.Result.Error, c.Result.Error)
+			}
+			if c.Result.TimedOut != test.timedOut {
+				t.Errorf("expected TimedOut to be %t", test.timedOut)
+			}
+		})
+	}
+}
+
+func TestExecUnsyncedAwaitSlot(t *testing.T) {
+	s := newServer(t)
+
+	c := testcases.NewCase(testcases.CaseConfig{
+		Method:    "GET",
+		Route:     "/eth/v1/beacon/headers/head",
+		AwaitSlot: headSlot + 1000,
+	})
+	c.SlotTimeout = 3 * time.Second
+	c.Exec(s.Context(context.Background()), "/")
+
+	if !c.Result.TimedOut {
+		t.Fatalf("expected the case to time out waiting for its slot, got: %v", c.Result.Error)
+	}
+	if c.Result.StatusCode != 0 {
+		t.Errorf("expected no request to be made, got status code %d", c.Result.StatusCode)
+	}
+}
+
+func TestExecFutureAwaitSlot(t *testing.T) {
+	s := newServer(t)
+
+	ctx, cancel := context.WithCancel(s.Context(context.Background()))
+	defer cancel()
+	clock, err := target.ReadSlotClock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx = target.WithSlotClock(ctx, clock)
+	w := target.NewSlotWatcher(time.Second)
+	go w.Run(ctx)
+	ctx = target.WithSlotWatcher(ctx, w)
+
+	// The slot has not begun by the wall clock, so the case waits for it
+	// to begin and gives up before polling for it.
+	c := testcases.NewCase(testcases.CaseConfig{
+		Method:    "GET",
+		Route:     "/eth/v1/beacon/headers/head",
+		AwaitSlot: headSlot + 1000,
+	})
+	c.SlotTimeout = time.Second
+	c.Exec(ctx, "/")
+
+	timeoutErr, ok := c.Result.Error.(testcases.TimeoutError)
+	if !ok {
+		t.Fatalf("expected a TimeoutError, got a %T: %v", c.Result.Error, c.Result.Error)
+	}
+	missing, ok := timeoutErr.Err.(*target.ClientMissingTargetSlotErr)
+	if !ok {
+		t.Fatalf("expected a *ClientMissingTargetSlotErr, got a %T: %v", timeoutErr.Err, timeoutErr.Err)
+	}
+	if missing.CurrentUnknown || missing.Current < headSlot || missing.Current > headSlot+1 {
+		t.Errorf("expected the error to report the head slot %d, got: %s", headSlot, missing)
+	}
+}
+
+func TestExecNumericHeadSlot(t *testing.T) {
+	s := newServer(t)
+	s.Respond("GET", "/eth/v1/node/syncing", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"head_slot": headSlot, "sync_distance": 0}})
+
+	ctx, cancel := context.WithCancel(s.Context(context.Background()))
+	defer cancel()
+	w := target.NewSlotWatcher(time.Second)
+	go w.Run(ctx)
+	ctx = target.WithSlotWatcher(ctx, w)
+
+	c := testcases.NewCase(testcases.CaseConfig{
+		Method:    "GET",
+		Route:     "/eth/v1/beacon/headers/head",
+		AwaitSlot: 1,
+	})
+	c.SlotTimeout = time.Second
+	c.Exec(ctx, "/")
+
+	if !c.Result.TimedOut {
+		t.Fatalf("expected the case to time out waiting for a readable head slot, got: %v", c.Result.Error)
+	}
+}
+
+func TestExecSharedConditions(t *testing.T) {
+	s := newServer(t)
+
+	var polls int32
+	s.Handle("GET", "/eth/v1/beacon/states/head/finality_checkpoints", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		s.Node.ServeHTTP(w, r)
+	}))
+
+	ctx := s.Context(context.Background())
+	ctx = target.WithConditionWatcher(ctx, target.NewConditionWatcher(time.Second))
+
+	// The finalized epoch is never reached, so every case waits until its
+	// slot timeout.
+	cases := []*testcases.Case{}
+	for i := 0; i < 10; i++ {
+		c := testcases.NewCase(testcases.CaseConfig{
+			Method: "GET",
+			Route:  "/eth/v1/node/version",
+			Await:  &testcases.AwaitConfig{FinalizedEpoch: 1000},
+		})
+		c.SlotTimeout = 2500 * time.Millisecond
+		cases = append(cases, c)
+		go c.Exec(ctx, "/")
+	}
+	for _, c := range cases {
+		<-c.Done
+		if !c.Result.TimedOut {
+			t.Fatalf("expected the case to time out waiting for finality, got: %v", c.Result.Error)
+		}
+	}
+
+	// About one poll per second, rather than one per second for each case.
+	if n := atomic.LoadInt32(&polls); n < 1 || n > 5 {
+		t.Errorf("expected the waiting cases to share about 3 polls, got %d", n)
+	}
+}
+
+func TestExecAllRequestLimit(t *testing.T) {
+	s := newServer(t)
+
+	// Both the cases' requests and the lookups of their variables are
+	// tracked.
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	tracked := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+
+		sleep(s, 20*time.Millisecond).ServeHTTP(w, r)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	})
+	s.Handle("GET", "/eth/v1/node/version", tracked)
+	s.Handle("GET", "/eth/v1/beacon/headers/head", tracked)
+
+	cases := []*testcases.Case{}
+	for i := 0; i < 10; i++ {
+		cases = append(cases, testcases.NewCase(testcases.CaseConfig{
+			Method:      "GET",
+			Route:       "/eth/v1/node/version",
+			QueryParams: map[string]string{"slot": "${head_slot}"},
+		}))
+	}
+
+	ctx, cancel := context.WithTimeout(s.Context(context.Background()), 10*time.Second)
+	defer cancel()
+	testcases.ExecAll(ctx, cases, "/", 2)
+	for _, c := range cases {
+		<-c.Done
+		if !c.Result.Success {
+			t.Fatalf("expected the case to pass, got: %s", c.Result.Error)
+		}
+	}
+
+	if maxInFlight > 2 {
+		t.Errorf("expected at most 2 requests in flight, got %d", maxInFlight)
+	}
+}
+
+func TestExecSkipped(t *testing.T) {
+	s := newServer(t)
+
+	c := testcases.NewCase(testcases.CaseConfig{
+		Method: "GET",
+		Route:  "/eth/v1/beacon/genesis",
+	})
+	c.Exec(s.Context(context.Background()), "/eth/v1/node")
+
+	if !c.Skipped {
+		t.Fatal("expected a case outside the paths root to be skipped")
+	}
+}
+
+func TestExecScenario(t *testing.T) {
+	s := newServer(t)
+
+	c := s.Exec(context.Background(), testcases.CaseConfig{
+		Name: "header of the head root",
+		Steps: []testcases.Step{
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method: "GET",
+					Route:  "/eth/v1/beacon/blocks/head/root",
+				},
+				Capture: map[string]string{"root": "$.data.root"},
+			},
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method:     "GET",
+					Route:      "/eth/v1/beacon/headers/${root}",
+					Assertions: []testcases.Assertion{{Path: "$.data.root", Equals: "${root}"}},
+				},
+			},
+		},
+	})
+
+	if !c.Result.Success {
+		t.Fatalf("expected the scenario to pass, got: %s", c.Result.Error)
+	}
+}
+
+func TestExecScenarioInvalidCapturedRegex(t *testing.T) {
+	s := newServer(t)
+	s.Respond("GET", "/eth/v1/node/version", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": "Foo/(v1"}})
+
+	c := s.Exec(context.Background(), testcases.CaseConfig{
+		Name: "version matches itself",
+		Steps: []testcases.Step{
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method: "GET",
+					Route:  "/eth/v1/node/version",
+				},
+				Capture: map[string]string{"v": "$.data.version"},
+			},
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method:     "GET",
+					Route:      "/eth/v1/node/version",
+					Assertions: []testcases.Assertion{{Path: "$.data.version", Regex: "^${v}$"}},
+				},
+			},
+		},
+	})
+
+	if c.Result.Success {
+		t.Fatal("expected an assertion whose regex is invalid once resolved to fail")
+	}
+	if _, ok := c.Result.Error.(testcases.StepError); !ok {
+		t.Fatalf("expected a StepError, got a %T: %s", c.Result.Error, c.Result.Error)
+	}
+}
+
+func TestExecScenarioInvalidCapturedHeaderRegex(t *testing.T) {
+	s := newServer(t)
+	s.Respond("GET", "/eth/v1/node/version", http.StatusOK, map[string]interface{}{"data": map[string]interface{}{"version": "Foo/(v1"}})
+
+	c := s.Exec(context.Background(), testcases.CaseConfig{
+		Name: "content type matches the version",
+		Steps: []testcases.Step{
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method: "GET",
+					Route:  "/eth/v1/node/version",
+				},
+				Capture: map[string]string{"v": "$.data.version"},
+			},
+			{
+				CaseConfig: testcases.CaseConfig{
+					Method:              "GET",
+					Route:               "/eth/v1/node/version",
+					ExpectedRespHeaders: map[string]testcases.HeaderExpectation{"Content-Type": {Regex: "^${v}$"}},
+				},
+			},
+		},
+	})
+
+	if c.Result.Success {
+		t.Fatal("expected a header expectation whose regex is invalid once resolved to fail")
+	}
+	if _, ok := c.Result.Error.(testcases.StepError); !ok {
+		t.Fatalf("expected a StepError, got a %T: %s", c.Result.Error, c.Result.Error)
+	}
+}
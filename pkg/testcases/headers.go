@@ -0,0 +1,128 @@
+package testcases
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// HeaderExpectation is an expectation for a response header. In a case file,
+// it is either a string, which the header must equal exactly, or an object
+// with any of the equals, regex and present fields.
+type HeaderExpectation struct {
+	// Equals is the exact value the header must have.
+	Equals string
+	// Regex is a regular expression the value of the header must match.
+	Regex string
+	// Present requires the header to be present if true, or absent if false.
+	Present *bool
+}
+
+func (e *HeaderExpectation) UnmarshalJSON(data []byte) error {
+	var equals string
+	if err := json.Unmarshal(data, &equals); err == nil {
+		*e = HeaderExpectation{Equals: equals}
+		return nil
+	}
+
+	// Decode into a type without this method to use the default behaviour.
+	type headerExpectation HeaderExpectation
+	return json.Unmarshal(data, (*headerExpectation)(e))
+}
+
+type HeaderMismatchError struct {
+	Failures []string
+	Received http.Header
+}
+
+func (e HeaderMismatchError) Error() string {
+	return fmt.Sprintf("Response headers do not satisfy the expected headers:\n%s\n\nReceived headers:\n%s", strings.Join(e.Failures, "\n"), formatHeader(e.Received))
+}
+
+// validate checks that the expectation is well-formed.
+func (e HeaderExpectation) validate() error {
+	if e.Regex != "" {
+		if _, err := regexp.Compile(e.Regex); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// check returns a description of every way in which the named header fails
+// the expectation. It returns an error if the regex, which may have had
+// variables substituted into it since it was validated, is invalid.
+func (e HeaderExpectation) check(name string, header http.Header) ([]string, error) {
+	var regex *regexp.Regexp
+	if e.Regex != "" {
+		var err error
+		if regex, err = regexp.Compile(e.Regex); err != nil {
+			return nil, fmt.Errorf("Regex %s of the expectation for header %s is invalid: %s", e.Regex, name, err.Error())
+		}
+	}
+
+	failures := []string{}
+	values, present := header[http.CanonicalHeaderKey(name)]
+	value := strings.Join(values, ", ")
+
+	if e.Present != nil {
+		if *e.Present && !present {
+			failures = append(failures, fmt.Sprintf("%s: is missing", name))
+		}
+		if !*e.Present && present {
+			failures = append(failures, fmt.Sprintf("%s: is present", name))
+		}
+	}
+
+	if e.Equals != "" && value != e.Equals {
+		failures = append(failures, fmt.Sprintf("%s: %q is not %q", name, value, e.Equals))
+	}
+
+	if regex != nil && !regex.MatchString(value) {
+		failures = append(failures, fmt.Sprintf("%s: %q does not match %s", name, value, e.Regex))
+	}
+
+	return failures, nil
+}
+
+// assertHeaders checks the response headers against the Case's expected
+// headers.
+func (c Case) assertHeaders(header http.Header) error {
+	names := make([]string, 0, len(c.Config.ExpectedRespHeaders))
+	for name := range c.Config.ExpectedRespHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	failures := []string{}
+	for _, name := range names {
+		nameFailures, err := c.Config.ExpectedRespHeaders[name].check(name, header)
+		if err != nil {
+			return err
+		}
+		failures = append(failures, nameFailures...)
+	}
+	if len(failures) > 0 {
+		return HeaderMismatchError{Failures: failures, Received: header}
+	}
+
+	return nil
+}
+
+// formatHeader formats headers one per line, sorted by name.
+func formatHeader(header http.Header) string {
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	lines := make([]string, len(names))
+	for i, name := range names {
+		lines[i] = fmt.Sprintf("%s: %s", name, strings.Join(header[name], ", "))
+	}
+	return strings.Join(lines, "\n")
+}
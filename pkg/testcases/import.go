@@ -7,9 +7,11 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 )
 
 // TestsCasesOpts are used to configure statically defined test cases with
@@ -27,6 +29,17 @@ type TestsCasesOpts struct {
 	// testsRoot is a file path to a directory tree containing well-specified
 	// JSON tests cases.
 	TestsRoot string
+	// Strict enables strict response validation for all test cases,
+	// regardless of their own strict setting.
+	Strict bool
+	// SlotTimeout bounds how long each test case waits for its await slot.
+	SlotTimeout time.Duration
+	// RequestTimeout bounds the execution of each test case's operation,
+	// unless the test case specifies its own timeout.
+	RequestTimeout time.Duration
+	// CompareTargets are further targets every test case is executed
+	// against. Their responses must agree with those of Target.
+	CompareTargets []url.URL
 }
 
 // All returns an array of executable test cases for the directory tree
@@ -61,7 +74,13 @@ func All(opts *TestsCasesOpts) ([]*Case, error) {
 		if err != nil {
 			return nil, err
 		}
+		if opts.Strict {
+			config.Strict = true
+		}
 		c := NewCase(config)
+		c.SlotTimeout = opts.SlotTimeout
+		c.RequestTimeout = opts.RequestTimeout
+		c.CompareTargets = opts.CompareTargets
 
 		cases = append(cases, c)
 
@@ -130,6 +149,15 @@ func getConfigsFromDirTree(root string, configs []CaseConfig) ([]CaseConfig, err
 				}
 			}
 
+			err = config.validate()
+			if err != nil {
+				return nil, TestSpecificationError{
+					Filepath: filePath,
+					Err:      err,
+				}
+			}
+
+			config.Source = filePath
 			configs = append(configs, config)
 		}
 	}
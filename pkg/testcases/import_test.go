@@ -0,0 +1,49 @@
+package testcases_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+func TestAllIllFormedEvents(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+	}{
+		{
+			name:     "no topics",
+			contents: `{"method": "GET", "route": "/eth/v1/events", "events": {"count": 1}}`,
+		},
+		{
+			name:     "unknown topic",
+			contents: `{"method": "GET", "route": "/eth/v1/events", "events": {"topics": ["heads"]}}`,
+		},
+		{
+			name:     "malformed duration",
+			contents: `{"method": "GET", "route": "/eth/v1/events", "events": {"topics": ["head"], "duration": "5"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testsRoot, err := ioutil.TempDir("", "tests")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testsRoot)
+			if err := ioutil.WriteFile(filepath.Join(testsRoot, "events.json"), []byte(test.contents), 0666); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = testcases.All(&testcases.TestsCasesOpts{TestsRoot: testsRoot})
+			if _, ok := err.(testcases.TestSpecificationError); !ok {
+				t.Fatalf("expected a TestSpecificationError, got a %T: %v", err, err)
+			}
+		})
+	}
+}
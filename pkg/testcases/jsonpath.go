@@ -0,0 +1,210 @@
+package testcases
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type JSONPathError struct {
+	Path    string
+	Message string
+}
+
+func (e JSONPathError) Error() string {
+	return fmt.Sprintf("Invalid JSONPath %q: %s", e.Path, e.Message)
+}
+
+// jsonPath is a parsed JSONPath selector. The supported syntax is the root
+// "$", children by name (".name" or "['name']"), array elements by index
+// ("[0]", or "[-1]" for the last), wildcards (".*" or "[*]") and recursive
+// descent ("..name", "..*" or "..[0]"). The leading "$" may be omitted, in
+// which case the path starts at the root, e.g. "data.root".
+type jsonPath struct {
+	source   string
+	segments []pathSegment
+}
+
+type pathSegment struct {
+	// recursive applies the segment to the node and all its descendants.
+	recursive bool
+	wildcard  bool
+	name      string
+	index     *int
+}
+
+// pathNode is a value selected by a jsonPath, with the container it was
+// selected from so that it can be removed.
+type pathNode struct {
+	value  interface{}
+	parent interface{}
+	key    string
+	index  int
+}
+
+func parseJSONPath(path string) (jsonPath, error) {
+	parsed := jsonPath{source: path}
+	rest := strings.TrimSpace(path)
+
+	switch {
+	case strings.HasPrefix(rest, "$"):
+		rest = rest[1:]
+	case strings.HasPrefix(rest, "[") || strings.HasPrefix(rest, "."):
+	default:
+		rest = "." + rest
+	}
+
+	for len(rest) > 0 {
+		segment := pathSegment{}
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			segment.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				break
+			}
+			name, remaining := splitPathName(rest)
+			if name == "" {
+				return jsonPath{}, JSONPathError{Path: path, Message: "expected a name after \"..\""}
+			}
+			segment.wildcard = name == "*"
+			segment.name = name
+			rest = remaining
+			parsed.segments = append(parsed.segments, segment)
+			continue
+		case strings.HasPrefix(rest, "."):
+			name, remaining := splitPathName(rest[1:])
+			if name == "" {
+				return jsonPath{}, JSONPathError{Path: path, Message: "expected a name after \".\""}
+			}
+			segment.wildcard = name == "*"
+			segment.name = name
+			rest = remaining
+			parsed.segments = append(parsed.segments, segment)
+			continue
+		case !strings.HasPrefix(rest, "["):
+			return jsonPath{}, JSONPathError{Path: path, Message: fmt.Sprintf("unexpected %q", rest)}
+		}
+
+		end := strings.Index(rest, "]")
+		if end < 0 {
+			return jsonPath{}, JSONPathError{Path: path, Message: "unterminated \"[\""}
+		}
+		selector := strings.TrimSpace(rest[1:end])
+		rest = rest[end+1:]
+
+		switch {
+		case selector == "*":
+			segment.wildcard = true
+		case len(selector) >= 2 && (selector[0] == '\'' || selector[0] == '"') && selector[len(selector)-1] == selector[0]:
+			segment.name = selector[1 : len(selector)-1]
+		default:
+			index, err := strconv.Atoi(selector)
+			if err != nil {
+				return jsonPath{}, JSONPathError{Path: path, Message: fmt.Sprintf("invalid selector [%s]", selector)}
+			}
+			segment.index = &index
+		}
+		parsed.segments = append(parsed.segments, segment)
+	}
+
+	return parsed, nil
+}
+
+// splitPathName splits a name, which ends at the next "." or "[", from the
+// rest of a path.
+func splitPathName(path string) (string, string) {
+	end := strings.IndexAny(path, ".[")
+	if end < 0 {
+		return path, ""
+	}
+	return path[:end], path[end:]
+}
+
+// selectNodes returns the nodes of the document the path selects, in document
+// order.
+func (p jsonPath) selectNodes(document interface{}) []pathNode {
+	nodes := []pathNode{{value: document}}
+	for _, segment := range p.segments {
+		selected := []pathNode{}
+		for _, node := range nodes {
+			if segment.recursive {
+				for _, descendant := range descendants(node) {
+					selected = append(selected, segment.apply(descendant)...)
+				}
+				continue
+			}
+			selected = append(selected, segment.apply(node)...)
+		}
+		nodes = selected
+	}
+	return nodes
+}
+
+// apply selects the children of node matched by the segment.
+func (s pathSegment) apply(node pathNode) []pathNode {
+	switch container := node.value.(type) {
+	case map[string]interface{}:
+		if s.wildcard {
+			keys := make([]string, 0, len(container))
+			for key := range container {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			children := []pathNode{}
+			for _, key := range keys {
+				children = append(children, pathNode{value: container[key], parent: container, key: key})
+			}
+			return children
+		}
+		if s.index != nil {
+			return nil
+		}
+		if value, ok := container[s.name]; ok {
+			return []pathNode{{value: value, parent: container, key: s.name}}
+		}
+
+	case []interface{}:
+		if s.wildcard {
+			children := []pathNode{}
+			for i, value := range container {
+				children = append(children, pathNode{value: value, parent: container, index: i})
+			}
+			return children
+		}
+		if s.index == nil {
+			return nil
+		}
+		i := *s.index
+		if i < 0 {
+			i += len(container)
+		}
+		if i >= 0 && i < len(container) {
+			return []pathNode{{value: container[i], parent: container, index: i}}
+		}
+	}
+
+	return nil
+}
+
+// descendants returns node and all nodes beneath it.
+func descendants(node pathNode) []pathNode {
+	nodes := []pathNode{node}
+	for _, child := range (pathSegment{wildcard: true}).apply(node) {
+		nodes = append(nodes, descendants(child)...)
+	}
+	return nodes
+}
+
+// remove removes the node from its container. Array elements are replaced by
+// null rather than removed, so that the indices of other elements stay the
+// same.
+func (n pathNode) remove() {
+	switch parent := n.parent.(type) {
+	case map[string]interface{}:
+		delete(parent, n.key)
+	case []interface{}:
+		parent[n.index] = nil
+	}
+}
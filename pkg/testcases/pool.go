@@ -0,0 +1,22 @@
+package testcases
+
+import (
+	"context"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+)
+
+// ExecAll starts executing every case at once, with at most concurrency of
+// their requests in flight, and returns without waiting for them. Every
+// request counts towards concurrency, including those looking up variables,
+// cross-checking events and comparing targets, but cases waiting for their
+// await slots or conditions do not. Each Case's Done channel is closed once it
+// has been executed. If concurrency is less than one, the requests are not
+// limited.
+func ExecAll(ctx context.Context, cases []*Case, pathsRoot string, concurrency int) {
+	ctx = oapi.WithRequestLimit(ctx, concurrency)
+
+	for _, c := range cases {
+		go c.Exec(ctx, pathsRoot)
+	}
+}
@@ -0,0 +1,172 @@
+package testcases
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type UnrecordableCaseError struct {
+	Reason string
+}
+
+func (e UnrecordableCaseError) Error() string {
+	return fmt.Sprintf("Cannot record this case: %s", e.Reason)
+}
+
+// Record executes the Case's operation and writes the status code and the
+// canonicalized body of the response into the Case's file, as its
+// expectedRespStatus and expectedRespBody. Every other field of the file is
+// kept as it is. If goldenDir is not empty, the file is written beneath it at
+// the same path relative to testsRoot instead, leaving the original untouched.
+// Record returns the path of the file it wrote.
+func (c *Case) Record(ctx context.Context, testsRoot, goldenDir string) (string, error) {
+	if c.Config.Source == "" {
+		return "", UnrecordableCaseError{Reason: "it was not read from a file"}
+	}
+	if c.Config.Events != nil {
+		return "", UnrecordableCaseError{Reason: "event streams have no fixed response"}
+	}
+	if len(c.Config.Steps) > 0 {
+		return "", UnrecordableCaseError{Reason: "scenarios have a response for each step"}
+	}
+	// The response to a case which references variables is only right for
+	// their values at the time it is recorded.
+	if names := c.Config.variableNames(); len(names) > 0 {
+		return "", UnrecordableCaseError{Reason: fmt.Sprintf("it references the variables %s, whose values change", strings.Join(names, ", "))}
+	}
+
+	if _, err := c.await(ctx); err != nil {
+		return "", err
+	}
+
+	// Error responses are recorded just like successful ones, so only a
+	// missing response is a failure here.
+	result, err := c.execOperation(ctx)
+	if result == nil || result.StatusCode == nil {
+		if err == nil {
+			err = fmt.Errorf("no response")
+		}
+		return "", err
+	}
+
+	// Error responses of the generated executors have only their raw body.
+	body, err := canonicalize(result)
+	if result.Response == nil {
+		body, err = actualBody(result)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	outPath := c.Config.Source
+	if goldenDir != "" {
+		relPath, err := filepath.Rel(testsRoot, c.Config.Source)
+		if err != nil {
+			return "", err
+		}
+		outPath = filepath.Join(goldenDir, relPath)
+	}
+
+	original, err := ioutil.ReadFile(c.Config.Source)
+	if err != nil {
+		return "", err
+	}
+	recorded, err := setFields(original, []field{
+		{key: "expectedRespStatus", value: *result.StatusCode},
+		{key: "expectedRespBody", value: body},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(outPath), 0777); err != nil {
+		return "", err
+	}
+	if err := ioutil.WriteFile(outPath, recorded, 0666); err != nil {
+		return "", err
+	}
+
+	return outPath, nil
+}
+
+// field is a key and value of a JSON object.
+type field struct {
+	key   string
+	value interface{}
+}
+
+// setFields sets fields of the JSON object in data, and returns the new JSON
+// document. Existing fields keep their order and formatting, and new fields
+// are appended in the order given. A nil value removes a field.
+func setFields(data []byte, fields []field) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return nil, fmt.Errorf("test case is not a JSON object")
+	}
+
+	keys := []string{}
+	values := map[string]json.RawMessage{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+		if _, ok := values[key]; !ok {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+
+	for _, f := range fields {
+		// Case files are decoded case-insensitively, so an existing field
+		// may be spelled differently.
+		for _, key := range keys {
+			if strings.EqualFold(key, f.key) {
+				f.key = key
+			}
+		}
+
+		if f.value == nil {
+			delete(values, f.key)
+			continue
+		}
+		value, err := json.MarshalIndent(f.value, "  ", "  ")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := values[f.key]; !ok {
+			keys = append(keys, f.key)
+		}
+		values[f.key] = value
+	}
+
+	out := &bytes.Buffer{}
+	out.WriteString("{\n")
+	written := 0
+	for _, key := range keys {
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+		if written > 0 {
+			out.WriteString(",\n")
+		}
+		encodedKey, _ := json.Marshal(key)
+		fmt.Fprintf(out, "  %s: %s", encodedKey, value)
+		written++
+	}
+	out.WriteString("\n}\n")
+
+	return out.Bytes(), nil
+}
@@ -0,0 +1,163 @@
+package testcases_test
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/INFURA/eth2-comply/pkg/mock"
+	"github.com/INFURA/eth2-comply/pkg/selftest"
+	"github.com/INFURA/eth2-comply/pkg/testcases"
+)
+
+// recordFile writes a case file with contents beneath a new tests root, records
+// it into a new golden directory, and returns the contents of the recorded
+// file.
+func recordFile(t *testing.T, s *selftest.Server, name, contents string) string {
+	t.Helper()
+
+	testsRoot, err := ioutil.TempDir("", "tests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testsRoot)
+	goldenDir, err := ioutil.TempDir("", "golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(goldenDir)
+
+	source := filepath.Join(testsRoot, "node", name)
+	if err := os.MkdirAll(filepath.Dir(source), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(source, []byte(contents), 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	cases, err := testcases.All(&testcases.TestsCasesOpts{Target: s.URL, TestsRoot: testsRoot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 1 {
+		t.Fatalf("expected 1 case, got %d", len(cases))
+	}
+	path, err := cases[0].Record(s.Context(context.Background()), testsRoot, goldenDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := filepath.Join(goldenDir, "node", name); path != expected {
+		t.Errorf("expected the case to be recorded to %s, got %s", expected, path)
+	}
+	original, err := ioutil.ReadFile(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(original) != contents {
+		t.Errorf("expected the original case file to be left untouched, got:\n%s", original)
+	}
+
+	recorded, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(recorded)
+}
+
+func TestRecordGoldenDir(t *testing.T) {
+	s := newServer(t)
+
+	// Existing fields keep their order and formatting, an existing
+	// expectation is replaced in place, and a new one is appended.
+	recorded := recordFile(t, s, "version.json", `{
+  "route": "/eth/v1/node/version",
+  "method": "GET",
+  "queryParams": {"b": "2",   "a": "1"},
+  "expectedRespStatus": 500
+}
+`)
+	expected := `{
+  "route": "/eth/v1/node/version",
+  "method": "GET",
+  "queryParams": {"b": "2",   "a": "1"},
+  "expectedRespStatus": 200,
+  "expectedRespBody": {
+    "data": {
+      "version": "` + mock.Version + `"
+    }
+  }
+}
+`
+	if recorded != expected {
+		t.Errorf("expected the recorded case:\n%s\ngot:\n%s", expected, recorded)
+	}
+}
+
+func TestRecordErrorStatus(t *testing.T) {
+	s := newServer(t)
+	s.Respond("GET", "/eth/v1/node/version", http.StatusInternalServerError, map[string]interface{}{"code": 500, "message": "Internal error"})
+
+	recorded := recordFile(t, s, "version.json", `{
+  "method": "GET",
+  "route": "/eth/v1/node/version"
+}
+`)
+	expected := `{
+  "method": "GET",
+  "route": "/eth/v1/node/version",
+  "expectedRespStatus": 500,
+  "expectedRespBody": {
+    "code": 500,
+    "message": "Internal error"
+  }
+}
+`
+	if recorded != expected {
+		t.Errorf("expected the recorded case:\n%s\ngot:\n%s", expected, recorded)
+	}
+}
+
+func TestRecordVariables(t *testing.T) {
+	s := newServer(t)
+
+	dir, err := ioutil.TempDir("", "record")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "header.json")
+	original := []byte(`{
+  "method": "GET",
+  "route": "/eth/v1/beacon/headers/${head_slot}",
+  "expectedRespBody": {"data": {"header": {"message": {"slot": "${head_slot}"}}}}
+}
+`)
+	if err := ioutil.WriteFile(source, original, 0666); err != nil {
+		t.Fatal(err)
+	}
+
+	c := testcases.NewCase(testcases.CaseConfig{
+		Method: "GET",
+		Route:  "/eth/v1/beacon/headers/${head_slot}",
+		Source: source,
+	})
+	if _, err := c.Record(s.Context(context.Background()), dir, ""); err == nil {
+		t.Fatal("expected a case which references variables not to be recorded")
+	} else if _, ok := err.(testcases.UnrecordableCaseError); !ok {
+		t.Fatalf("expected an UnrecordableCaseError, got a %T: %s", err, err)
+	}
+
+	recorded, err := ioutil.ReadFile(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(recorded, original) {
+		t.Errorf("expected the case file to be left untouched, got:\n%s", recorded)
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
+	"github.com/INFURA/eth2-comply/pkg/apispec"
 	"github.com/INFURA/eth2-comply/pkg/oapi"
 )
 
@@ -18,178 +20,241 @@ func (e UnimplementedOperationError) Error() string {
 	return fmt.Sprintf("Tests for the operation %s %s are not supported.", e.method, e.route)
 }
 
-// execOperation is a big if-else tree that uses the CaseConfig method and
-// route to determine the appropriate OAPI executor to use for actually
-// executing the operation under test.
-//
-// For routes that use path params, it is the responsibility of the case for
-// that route in this function to extract the path param and pass it along to
-// the constituent oapi executor function.
-func (c Case) execOperation(ctx context.Context) (*oapi.ExecutorResult, error) {
-	_route, err := url.Parse(c.Config.Route)
-	if err != nil {
-		return nil, err
-	}
-	route := _route.RequestURI()
+// executor runs the operation under test for a Case. params holds the path
+// params extracted from the Case's route, keyed by their names in the spec.
+type executor func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error)
 
-	switch c.Config.Method {
-	case "GET":
-		return c.execGetOperation(ctx, route)
-	}
+// executors maps spec operationIds to the OAPI executor used for actually
+// executing that operation. Supporting a new operation only requires adding
+// an entry here; the route is resolved from the spec by execOperation.
+var executors = map[string]executor{
+	// Beacon
+	"getGenesis": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconGenesis(ctx)
+	},
+	"getStateRoot": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconStatesRoot(ctx, params["state_id"])
+	},
+	"getStateFork": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconStatesFork(ctx, params["state_id"])
+	},
+	"getStateFinalityCheckpoints": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconStatesFinalityCheckpoints(ctx, params["state_id"])
+	},
+	"getStateValidators": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconStatesValidators(ctx, params["state_id"], c.Config.QueryParams)
+	},
+	"getStateValidator": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconStatesValidator(ctx, params["state_id"], params["validator_id"])
+	},
+	"getEpochCommittees": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		opts := &oapi.ExecGetBeaconStatesCommitteesOpts{
+			StateId:     params["state_id"],
+			Epoch:       params["epoch"],
+			QueryParams: c.Config.QueryParams,
+		}
+		return oapi.ExecGetBeaconStatesCommittees(ctx, opts)
+	},
+	"getBlockHeaders": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconHeaders(ctx, c.Config.QueryParams)
+	},
+	"getBlockHeader": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconHeader(ctx, params["block_id"])
+	},
+	"publishBlock": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostBeaconBlocks(ctx, c.Config.ReqBody)
+	},
+	"getBlock": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconBlock(ctx, params["block_id"])
+	},
+	"getBlockRoot": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconBlockRoot(ctx, params["block_id"])
+	},
+	"getBlockAttestations": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconBlockAttestations(ctx, params["block_id"])
+	},
+	"getPoolAttestations": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconPoolAttestations(ctx, c.Config.QueryParams)
+	},
+	"getPoolAttesterSlashings": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconPoolAttesterSlashings(ctx)
+	},
+	"getPoolProposerSlashings": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconPoolProposerSlashings(ctx)
+	},
+	"getPoolVoluntaryExits": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetBeaconPoolVoluntaryExits(ctx)
+	},
+	"submitPoolAttestations": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostBeaconPoolAttestations(ctx, c.Config.ReqBody)
+	},
+	"submitPoolAttesterSlashings": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostBeaconPoolAttesterSlashings(ctx, c.Config.ReqBody)
+	},
+	"submitPoolProposerSlashings": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostBeaconPoolProposerSlashings(ctx, c.Config.ReqBody)
+	},
+	"submitPoolVoluntaryExit": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostBeaconPoolVoluntaryExits(ctx, c.Config.ReqBody)
+	},
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
+	// Config
+	"getForkSchedule": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetConfigForkSchedule(ctx)
+	},
+	"getSpec": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetConfigSpec(ctx)
+	},
+	"getDepositContract": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetConfigDepositContract(ctx)
+	},
 
-func (c Case) execGetOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	switch {
-	case strings.Contains(route, "/validator/"):
-		return c.execGetValidatorOperation(ctx, route)
-	case strings.Contains(route, "/node/"):
-		return c.execGetNodeOperation(ctx, route)
-	case strings.Contains(route, "/debug/"):
-		return c.execGetDebugOperation(ctx, route)
-	case strings.Contains(route, "/beacon/"):
-		return c.execGetBeaconOperation(ctx, route)
-	case strings.Contains(route, "/config/"):
-		return c.execGetConfigOperation(ctx, route)
-	}
+	// Debug
+	"getState": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetDebugBeaconStates(ctx, params["state_id"])
+	},
+	"getDebugChainHeads": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetDebugBeaconHeads(ctx)
+	},
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
+	// Events
+	"eventstream": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		opts := oapi.ExecGetEventsOpts{Strict: c.Config.Strict}
+		if c.Config.Events != nil {
+			opts.Topics = c.Config.Events.Topics
+			opts.Count = c.Config.Events.Count
+			opts.CrossCheck = c.Config.Events.CrossCheck
+			if c.Config.Events.Duration != "" {
+				duration, err := time.ParseDuration(c.Config.Events.Duration)
+				if err != nil {
+					return nil, err
+				}
+				opts.Duration = duration
+			}
+		}
+		// Topics may also be given like any other query param, as a comma
+		// separated list.
+		if len(opts.Topics) == 0 && c.Config.QueryParams["topics"] != "" {
+			opts.Topics = strings.Split(c.Config.QueryParams["topics"], ",")
+		}
+		return oapi.ExecGetEvents(ctx, opts)
+	},
 
-func (c Case) execGetBeaconOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	uriTokens := strings.Split(route, "/")
+	// Node
+	"getNetworkIdentity": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodeIdentity(ctx)
+	},
+	"getPeers": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodePeers(ctx)
+	},
+	"getPeer": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodePeer(ctx, params["peer_id"])
+	},
+	"getNodeVersion": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodeVersion(ctx)
+	},
+	"getSyncingStatus": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodeSyncing(ctx)
+	},
+	"getHealth": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetNodeHealth(ctx)
+	},
 
-	switch {
-	case strings.Contains(route, "/genesis"):
-		return oapi.ExecGetBeaconGenesis(ctx)
-	case strings.Contains(route, "/headers/"):
-		blockId := uriTokens[5]
-		return oapi.ExecGetBeaconHeader(ctx, blockId)
-	case strings.Contains(route, "/headers"):
-		return oapi.ExecGetBeaconHeaders(ctx, c.Config.QueryParams)
-	case strings.Contains(route, "/blocks/"):
-		blockId := uriTokens[5]
-		switch {
-		case strings.Contains(route, "/root"):
-			return oapi.ExecGetBeaconBlockRoot(ctx, blockId)
-		case strings.Contains(route, "/attestations"):
-			return oapi.ExecGetBeaconBlockAttestations(ctx, blockId)
-		default:
-			return oapi.ExecGetBeaconBlock(ctx, blockId)
+	// Validator
+	"getAttesterDuties": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		opts := oapi.ExecGetValidatorDutiesAttesterOpts{
+			Epoch:       params["epoch"],
+			QueryParams: c.Config.QueryParams,
 		}
-	case strings.Contains(route, "/states/"):
-		return c.execGetBeaconStatesOperation(ctx, route)
-	}
-
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
+		return oapi.ExecGetValidatorDutiesAttester(ctx, opts)
+	},
+	"getProposerDuties": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetValidatorDutiesProposer(ctx, params["epoch"])
+	},
+	"produceBlock": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		opts := oapi.ExecGetValidatorBlocksOpts{
+			Slot:        params["slot"],
+			QueryParams: c.Config.QueryParams,
+		}
+		return oapi.ExecGetValidatorBlocks(ctx, opts)
+	},
+	"produceAttestationData": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetValidatorAttestationData(ctx, c.Config.QueryParams)
+	},
+	"getAggregatedAttestation": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecGetValidatorAggregateAttestation(ctx, c.Config.QueryParams)
+	},
+	"publishAggregateAndProof": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostValidatorAggregateAndProofs(ctx, c.Config.ReqBody)
+	},
+	"prepareBeaconCommitteeSubnet": func(ctx context.Context, c Case, params apispec.PathParams) (*oapi.ExecutorResult, error) {
+		return oapi.ExecPostValidatorBeaconCommitteeSubscriptions(ctx, c.Config.ReqBody)
+	},
 }
 
-func (c Case) execGetNodeOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	uriTokens := strings.Split(route, "/")
-
-	switch {
-	case strings.Contains(route, "/health"):
-		return oapi.ExecGetNodeHealth(ctx)
-	case strings.Contains(route, "/syncing"):
-		return oapi.ExecGetNodeSyncing(ctx)
-	case strings.Contains(route, "/version"):
-		return oapi.ExecGetNodeVersion(ctx)
-	case strings.Contains(route, "/peers/"):
-		peerId := uriTokens[len(uriTokens)-1]
-		return oapi.ExecGetNodePeer(ctx, peerId)
-	case strings.Contains(route, "/peers"):
-		return oapi.ExecGetNodePeers(ctx)
-	case strings.Contains(route, "/identity"):
-		return oapi.ExecGetNodeIdentity(ctx)
+// Do executes the Case's operation once and returns the target's response. It
+// neither waits for the Case's await slot nor checks its expectations, so
+// that the operation can be repeated, for example to measure performance.
+func (c Case) Do(ctx context.Context) (*oapi.ExecutorResult, error) {
+	return c.execOperation(ctx)
+}
 
+// execOperation matches the CaseConfig method and route against the path
+// templates in the spec to find the operation under test, and runs it with the
+// appropriate OAPI executor. Operations without an entry in executors are run
+// by oapi.ExecOperation, which validates responses directly against the spec.
+func (c Case) execOperation(ctx context.Context) (*oapi.ExecutorResult, error) {
+	_route, err := url.Parse(c.Config.Route)
+	if err != nil {
+		return nil, err
 	}
+	route := _route.Path
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
+	specRoute, params, ok := apispec.MatchRoute(c.Config.Method, route)
+	if !ok {
+		return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
+	}
 
-func (c Case) execGetBeaconStatesOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	uriTokens := strings.Split(route, "/")
-	stateId := uriTokens[5]
+	ctx, recording := oapi.WithRecording(ctx)
 
-	switch {
-	case strings.Contains(route, "/committees/"):
-		epoch := uriTokens[7]
-		opts := &oapi.ExecGetBeaconStatesCommitteesOpts{
-			StateId:     stateId,
-			Epoch:       epoch,
+	exec, ok := executors[specRoute.OperationID]
+	if !ok {
+		opts := oapi.ExecOperationOpts{
+			Route:       specRoute,
+			Path:        route,
 			QueryParams: c.Config.QueryParams,
+			ReqBody:     c.Config.ReqBody,
+			Strict:      c.Config.Strict,
 		}
-		return oapi.ExecGetBeaconStatesCommittees(ctx, opts)
-	case strings.Contains(route, "/finality_checkpoints"):
-		return oapi.ExecGetBeaconStatesFinalityCheckpoints(ctx, stateId)
-	case strings.Contains(route, "/fork"):
-		return oapi.ExecGetBeaconStatesFork(ctx, stateId)
-	case strings.Contains(route, "/root"):
-		return oapi.ExecGetBeaconStatesRoot(ctx, stateId)
-	case strings.Contains(route, "/validators/"):
-		validatorId := uriTokens[7]
-		return oapi.ExecGetBeaconStatesValidator(ctx, stateId, validatorId)
-	case strings.Contains(route, "/validators"):
-		return oapi.ExecGetBeaconStatesValidators(ctx, stateId, c.Config.QueryParams)
+		result, err := oapi.ExecOperation(ctx, opts)
+		if result != nil {
+			result.Latency = recording.Latency()
+		}
+		return result, err
 	}
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
-
-func (c Case) execGetDebugOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	uriTokens := strings.Split(route, "/")
-
-	switch {
-	case strings.Contains(route, "/states/"):
-		stateId := uriTokens[6]
-		return oapi.ExecGetDebugBeaconStates(ctx, stateId)
-	case strings.Contains(route, "/heads"):
-		return oapi.ExecGetDebugBeaconHeads(ctx)
+	result, err := exec(ctx, c, params)
+	if result != nil {
+		result.Latency = recording.Latency()
 	}
-
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
-
-func (c Case) execGetConfigOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	switch {
-	case strings.Contains(route, "/fork_schedule"):
-		return oapi.ExecGetConfigForkSchedule(ctx)
-	case strings.Contains(route, "/spec"):
-		return oapi.ExecGetConfigSpec(ctx)
-	case strings.Contains(route, "/deposit_contract"):
-		return oapi.ExecGetConfigDepositContract(ctx)
+	// The generated executors return no result for error statuses, so the
+	// result is built from the raw response, for its status and for strict
+	// validation of the error body. Other errors, like a body which cannot
+	// be decoded, are left without a result.
+	if result == nil && err != nil {
+		if raw := recording.Result(); raw != nil && isErrorStatus(*raw.StatusCode) {
+			result = raw
+		}
 	}
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
-}
-
-func (c Case) execGetValidatorOperation(ctx context.Context, route string) (*oapi.ExecutorResult, error) {
-	uriTokens := strings.Split(route, "/")
-
-	switch {
-	case strings.Contains(route, "/duties/"):
-		switch {
-		case strings.Contains(route, "/attester/"):
-			opts := oapi.ExecGetValidatorDutiesAttesterOpts{
-				Epoch:       uriTokens[6],
-				QueryParams: c.Config.QueryParams,
-			}
-			return oapi.ExecGetValidatorDutiesAttester(ctx, opts)
-		case strings.Contains(route, "/proposer/"):
-			return oapi.ExecGetValidatorDutiesProposer(ctx, uriTokens[6])
+	// The generated data structures decode responses leniently, so in strict
+	// mode the raw response is also validated against the spec, including
+	// error responses.
+	if c.Config.Strict && result != nil {
+		if err := oapi.ValidateResponse(specRoute, result, true); err != nil {
+			return nil, err
 		}
-	case strings.Contains(route, "/blocks/"):
-		opts := oapi.ExecGetValidatorBlocksOpts{
-			Slot:        uriTokens[5],
-			QueryParams: c.Config.QueryParams,
-		}
-		return oapi.ExecGetValidatorBlocks(ctx, opts)
-	case strings.Contains(route, "/attestation_data"):
-		return oapi.ExecGetValidatorAttestationData(ctx, c.Config.QueryParams)
-	case strings.Contains(route, "/aggregate_attestation"):
-		return oapi.ExecGetValidatorAggregateAttestation(ctx, c.Config.QueryParams)
 	}
 
-	return nil, UnimplementedOperationError{method: c.Config.Method, route: route}
+	return result, err
 }
@@ -0,0 +1,162 @@
+package testcases
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/INFURA/eth2-comply/pkg/oapi"
+	"github.com/INFURA/eth2-comply/pkg/target"
+)
+
+// Step is one request of a scenario. It is configured like any other case, and
+// may also capture values from its response into variables, which the steps
+// after it can refer to as "${name}".
+type Step struct {
+	CaseConfig
+	// Capture maps the names of variables to JSONPath selectors for the
+	// values to capture from the response body, e.g. "data.root". Each
+	// selector must select exactly one value.
+	Capture map[string]string
+}
+
+type StepError struct {
+	// Index is the position of the failed step in the scenario, from zero.
+	Index int
+	Step  string
+	Err   error
+}
+
+func (e StepError) Error() string {
+	return fmt.Sprintf("Step %d (%s) failed: %s", e.Index+1, e.Step, e.Err.Error())
+}
+
+type CaptureError struct {
+	Variable string
+	Path     string
+	Selected int
+}
+
+func (e CaptureError) Error() string {
+	return fmt.Sprintf("Cannot capture ${%s}: %s selects %d values rather than one", e.Variable, e.Path, e.Selected)
+}
+
+// describe identifies the step by its name, or by its method and route if it
+// has no name.
+func (s Step) describe() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return fmt.Sprintf("%s %s", s.Method, s.Route)
+}
+
+// validate checks that the step is well-formed.
+func (s Step) validate() error {
+	if len(s.Steps) > 0 {
+		return fmt.Errorf("Step %q cannot have steps of its own", s.describe())
+	}
+	if s.AwaitSlot > 0 || s.Await != nil {
+		return fmt.Errorf("Step %q cannot await anything; set awaitSlot and await on the scenario instead", s.describe())
+	}
+	for _, path := range s.Capture {
+		if _, err := parseJSONPath(path); err != nil {
+			return err
+		}
+	}
+	return s.CaseConfig.validate()
+}
+
+// execScenario executes the steps of a scenario Case in order, and stops at
+// the first step which fails. The Result of the Case has the total latency of
+// the steps, and the status code and headers of the last response. Built-in
+// variables are resolved once for the whole scenario, so every step sees the
+// same values.
+func (c *Case) execScenario(ctx context.Context) {
+	captured := map[string]interface{}{}
+	vars := newVariables(ctx, captured, target.NewVariableResolver())
+
+	for i, step := range c.Config.Steps {
+		fail := func(err error) {
+			c.setFailure(StepError{Index: i, Step: step.describe(), Err: err})
+		}
+
+		config, err := step.CaseConfig.resolve(vars)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if c.Config.Strict {
+			config.Strict = true
+		}
+
+		stepCase := &Case{
+			Config:         config,
+			RequestTimeout: c.RequestTimeout,
+			CompareTargets: c.CompareTargets,
+		}
+		result := stepCase.execRequest(ctx)
+
+		c.Result.Latency += stepCase.Result.Latency
+		c.Result.StatusCode = stepCase.Result.StatusCode
+		c.Result.Header = stepCase.Result.Header
+		if !stepCase.Result.Success {
+			fail(stepCase.Result.Error)
+			c.Result.TimedOut = stepCase.Result.TimedOut
+			return
+		}
+
+		if err := step.capture(result, captured); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	c.Result.Success = true
+}
+
+// capture sets the variables the step captures from the response body.
+func (s Step) capture(result *oapi.ExecutorResult, captured map[string]interface{}) error {
+	if len(s.Capture) == 0 {
+		return nil
+	}
+
+	body, err := actualBody(result)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(s.Capture))
+	for name := range s.Capture {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path, err := parseJSONPath(s.Capture[name])
+		if err != nil {
+			return err
+		}
+		nodes := path.selectNodes(body)
+		if len(nodes) != 1 {
+			return CaptureError{Variable: name, Path: s.Capture[name], Selected: len(nodes)}
+		}
+		captured[name] = nodes[0].value
+	}
+
+	return nil
+}
+
+// InPaths reports whether the Case is beneath the paths root, e.g.
+// "/eth/v1/node". A scenario is if any of its steps is.
+func (c *Case) InPaths(pathsRoot string) bool {
+	if len(c.Config.Steps) == 0 {
+		return strings.HasPrefix(c.Config.Route, pathsRoot)
+	}
+	for _, step := range c.Config.Steps {
+		if strings.HasPrefix(step.Route, pathsRoot) {
+			return true
+		}
+	}
+	return false
+}
@@ -8,9 +8,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
 	"strings"
+	"time"
 
-	"github.com/INFURA/eth2-comply/pkg/eth2spec"
+	"github.com/INFURA/eth2-comply/pkg/apispec"
 	"github.com/INFURA/eth2-comply/pkg/oapi"
 	"github.com/INFURA/eth2-comply/pkg/target"
 )
@@ -22,6 +27,17 @@ type Case struct {
 	Result  Result
 	Skipped bool
 	Done    chan struct{}
+	// SlotTimeout bounds the wait for the target to sync the Case's
+	// AwaitSlot and any other conditions it awaits. If it is zero, the wait
+	// is only bounded by the context passed to Exec.
+	SlotTimeout time.Duration
+	// RequestTimeout bounds the execution of the Case's operation, unless
+	// the CaseConfig sets its own Timeout. If it is zero, execution is only
+	// bounded by the context passed to Exec.
+	RequestTimeout time.Duration
+	// CompareTargets are targets whose responses must agree with those of
+	// the target in the context passed to Exec. See compareTargets.
+	CompareTargets []url.URL
 }
 
 // CaseConfig describes a test scenario.
@@ -33,12 +49,98 @@ type CaseConfig struct {
 	ReqBody            interface{}
 	ExpectedRespStatus int
 	ExpectedRespBody   interface{}
+	// ExpectedRespHeaders maps the names of response headers to
+	// expectations for them.
+	ExpectedRespHeaders map[string]HeaderExpectation
+	// BodyMatch is how ExpectedRespBody is compared to the response body,
+	// either BodyMatchExact (the default) or BodyMatchSubset.
+	BodyMatch string
+	// IgnorePaths are JSONPath selectors for values which are left out of
+	// both ExpectedRespBody and the response body before comparing them.
+	IgnorePaths []string
+	// Assertions are checks on values selected from the response body.
+	Assertions []Assertion
+	// Strict validates the response against the spec schema, failing on
+	// unknown, missing and malformed fields.
+	Strict bool
+	// Events configures a case for the event stream. See EventsConfig.
+	Events *EventsConfig
+	// Await describes conditions besides AwaitSlot to wait for before executing the
+	// case. See AwaitConfig.
+	Await *AwaitConfig
+	// Timeout bounds the execution of the operation, in Go duration syntax.
+	// It does not include waiting for AwaitSlot.
+	Timeout string
+	// MaxLatency is the longest the target may take to respond, in Go
+	// duration syntax. Unlike Timeout, the request is not abandoned when
+	// it is exceeded, but the Case fails.
+	MaxLatency string
+	// Name describes a scenario or one of its steps.
+	Name string
+	// Steps makes the CaseConfig a scenario: rather than making a request
+	// of its own, it makes the requests of its steps in order. See Step.
+	Steps []Step
+	// Source is the path of the file the CaseConfig was read from, if any.
+	Source string `json:"-"`
+}
+
+// EventsConfig describes how long a case for the event stream listens to it.
+type EventsConfig struct {
+	// Topics are the event topics to subscribe to, e.g. "head".
+	Topics []string
+	// Count is the number of events to wait for.
+	Count int
+	// Duration is how long to listen for, in Go duration syntax. If Count is
+	// also set, Count events must be received within Duration.
+	Duration string
+	// CrossCheck checks head, block and finalized_checkpoint events against
+	// the target's REST API as they are received.
+	CrossCheck bool
+}
+
+// validate checks that the EventsConfig is well-formed.
+func (e EventsConfig) validate() error {
+	if len(e.Topics) == 0 {
+		return fmt.Errorf("events.topics must list at least one of %s", strings.Join(apispec.Topics, ", "))
+	}
+	for _, topic := range e.Topics {
+		if _, ok := apispec.EventSchema(topic); !ok {
+			return fmt.Errorf("events.topics has %q, which is not one of %s", topic, strings.Join(apispec.Topics, ", "))
+		}
+	}
+	if e.Count < 0 {
+		return fmt.Errorf("events.count must not be negative")
+	}
+	if e.Duration != "" {
+		if _, err := time.ParseDuration(e.Duration); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Result describes the result of a test. Error is nil is success is true.
 type Result struct {
 	Success bool
-	Error   error
+	// TimedOut is true if the test failed because it ran out of time, either
+	// waiting for its slot or executing its operation.
+	TimedOut bool
+	Error    error
+	// Elapsed is the total time the test took to execute, including any
+	// time spent waiting for its await slot.
+	Elapsed time.Duration
+	// SlotWait is the time spent waiting for the target to sync the await
+	// slot and satisfy any other awaited conditions.
+	SlotWait time.Duration
+	// Latency is the time from sending the request to reading the last of
+	// the response body. Decoding and checking the response do not count.
+	Latency time.Duration
+	// StatusCode is the HTTP status code the target responded with, or zero
+	// if no response was received.
+	StatusCode int
+	// Header holds the headers the target responded with, if any response
+	// was received.
+	Header http.Header
 }
 
 type OapiError struct {
@@ -50,6 +152,53 @@ func (e OapiError) Error() string {
 	return fmt.Sprintf("OpenAPI client error!\nError: %s\nServer message: %s", e.Err.Error(), string(e.ServerResponse))
 }
 
+type StatusMismatchError struct {
+	Expected int
+	Received int
+}
+
+func (e StatusMismatchError) Error() string {
+	return fmt.Sprintf("Expected status code: %d\nReceived status code: %d", e.Expected, e.Received)
+}
+
+type BodyMismatchError struct {
+	Expected []byte
+	Received []byte
+	// Differences are the values the expected response body has which the
+	// received body does not contain, for subset matching.
+	Differences []Difference
+}
+
+func (e BodyMismatchError) Error() string {
+	if len(e.Differences) > 0 {
+		lines := make([]string, len(e.Differences))
+		for i, difference := range e.Differences {
+			lines[i] = difference.String()
+		}
+		return fmt.Sprintf("Response body does not contain the expected response body:\n%s\n\nReceived response body:\n%s", strings.Join(lines, "\n"), e.Received)
+	}
+	return fmt.Sprintf("Expected response body:\n%s\n\nReceived response body:\n%s", e.Expected, e.Received)
+}
+
+type LatencyError struct {
+	Latency    time.Duration
+	MaxLatency time.Duration
+}
+
+func (e LatencyError) Error() string {
+	return fmt.Sprintf("Response took %s, longer than the maximum latency of %s", e.Latency, e.MaxLatency)
+}
+
+type TimeoutError struct {
+	// Phase is what the Case was doing when it ran out of time.
+	Phase string
+	Err   error
+}
+
+func (e TimeoutError) Error() string {
+	return fmt.Sprintf("Timed out %s: %s", e.Phase, e.Err.Error())
+}
+
 // NewCase instantiates and returns a Case struct.
 func NewCase(config CaseConfig) *Case {
 	c := &Case{}
@@ -74,70 +223,294 @@ func NewCase(config CaseConfig) *Case {
 func (c *Case) Exec(ctx context.Context, pathsRoot string) {
 	defer close(c.Done)
 
+	start := time.Now()
+	defer func() {
+		c.Result.Elapsed = time.Since(start)
+	}()
+
 	// If a test should be excluded because it is not beneath the paths root,
 	// skip it here.
-	if !strings.HasPrefix(c.Config.Route, pathsRoot) {
+	if !c.InPaths(pathsRoot) {
 		c.Skipped = true
 		return
 	}
 
-	// If a test specifies an await slot, wait for the node to sync that slot.
-	if c.Config.AwaitSlot > 0 {
-		if err := target.HasSlot(ctx, c.Config.AwaitSlot); err != nil {
+	// If a test specifies an await slot or other conditions, wait for the
+	// node to satisfy them.
+	if c.awaits() {
+		slotCtx, cancel := WithTimeout(ctx, c.SlotTimeout)
+		slotStart := time.Now()
+		phase, err := c.await(slotCtx)
+		c.Result.SlotWait = time.Since(slotStart)
+		cancel()
+		if err != nil {
+			// Waits give up with the state they last saw once they have run
+			// out of time.
+			if isUnmetWait(err) || slotCtx.Err() == context.DeadlineExceeded {
+				c.setTimeout(TimeoutError{Phase: phase, Err: err})
+				return
+			}
 			c.setFailure(err)
 			return
 		}
 	}
 
-	result, err := c.execOperation(ctx)
+	if len(c.Config.Steps) > 0 {
+		c.execScenario(ctx)
+		return
+	}
+
+	resolved, err := c.Resolve(ctx)
+	if err != nil {
+		c.setFailure(err)
+		return
+	}
+	resolved.execRequest(ctx)
+	c.Result = resolved.Result
+}
+
+// execRequest executes the Case's operation and checks the response against
+// the Case's expectations, populating the Result. It returns the executor
+// result, if any, whether or not the Case succeeded.
+func (c *Case) execRequest(ctx context.Context) *oapi.ExecutorResult {
+	timeout := c.RequestTimeout
+	if c.Config.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(c.Config.Timeout)
+		if err != nil {
+			c.setFailure(err)
+			return nil
+		}
+	}
+
+	// The place among the requests in flight is taken before the request's
+	// own timeout starts.
+	ctx, release, err := oapi.AcquireRequest(ctx)
 	if err != nil {
+		c.setTimeout(TimeoutError{Phase: "waiting to execute the request", Err: err})
+		return nil
+	}
+	defer release()
+
+	reqCtx, cancel := WithTimeout(ctx, timeout)
+	defer cancel()
+
+	requestStart := time.Now()
+	result, err := c.execOperation(reqCtx)
+	// Without a response, the latency is the time until the request failed.
+	c.Result.Latency = time.Since(requestStart)
+	if result != nil && result.Latency > 0 {
+		c.Result.Latency = result.Latency
+	}
+	if result != nil && result.StatusCode != nil {
+		c.Result.StatusCode = *result.StatusCode
+		c.Result.Header = result.Header
+	}
+	if err != nil && reqCtx.Err() == context.DeadlineExceeded {
+		c.setTimeout(TimeoutError{Phase: "executing the request", Err: err})
+		return result
+	}
+	if err != nil && !c.expectsErrorStatus(result) {
 		// If the response is invalid in the OAPI schema, set that error here.
-		if oapiErr, ok := err.(eth2spec.GenericOpenAPIError); ok {
+		if oapiErr, ok := err.(responseBodyError); ok {
 			if len(oapiErr.Body()) > 0 {
 				c.setFailure(OapiError{Err: oapiErr, ServerResponse: oapiErr.Body()})
-				return
+				return result
 			}
 		}
 
 		// If an environmental error like a network failure occurred, set that
 		// failure here.
 		c.setFailure(err)
-		return
+		return result
 	}
 
 	err = c.assertExpectations(result)
 	if err != nil {
 		c.setFailure(err)
-		return
+		return result
+	}
+
+	if c.Config.MaxLatency != "" {
+		maxLatency, _ := time.ParseDuration(c.Config.MaxLatency)
+		if c.Result.Latency > maxLatency {
+			c.setFailure(LatencyError{Latency: c.Result.Latency, MaxLatency: maxLatency})
+			return result
+		}
+	}
+
+	// The events a stream delivers depend on when it is listened to, so
+	// event streams are not compared across targets.
+	if len(c.CompareTargets) > 0 && result != nil && c.Config.Events == nil {
+		err = c.compareTargets(reqCtx, result)
+		if err != nil && reqCtx.Err() == context.DeadlineExceeded {
+			c.setTimeout(TimeoutError{Phase: "executing the request", Err: err})
+			return result
+		}
+		if err != nil {
+			c.setFailure(err)
+			return result
+		}
 	}
 
 	c.Result.Success = true
+	return result
 }
 
 // ResultsPretty returns human-readable test results output suitable for
 // printing to a CLI.
 func (c Case) ResultsPretty() string {
-	// Build up a route string with the query params appended to the end
+	routeString := c.Name()
+
+	var resultString string
+	if c.Skipped {
+		resultString = fmt.Sprintf("%s skipped\n", routeString)
+	} else if c.Result.TimedOut {
+		resultString = fmt.Sprintf("%s ⏱\n%s", routeString, c.Result.Error.Error())
+	} else if !c.Result.Success {
+		resultString = fmt.Sprintf("%s ❌ %s\n%s", routeString, c.timingPretty(), c.Result.Error.Error())
+	} else {
+		resultString = fmt.Sprintf("%s ✅ %s\n", routeString, c.timingPretty())
+	}
+
+	// Headers which failed their expectations are already shown with the
+	// error.
+	if _, mismatched := c.Result.Error.(HeaderMismatchError); len(c.Config.ExpectedRespHeaders) > 0 && c.Result.Header != nil && !mismatched {
+		if !strings.HasSuffix(resultString, "\n") {
+			resultString += "\n"
+		}
+		resultString = fmt.Sprintf("%sReceived headers:\n%s\n", resultString, formatHeader(c.Result.Header))
+	}
+
+	return resultString
+}
+
+// timingPretty returns the timing of an executed Case, e.g. "(latency 12ms)",
+// or "(latency 12ms, waited 24s for slot 42)" for a Case with an await slot.
+func (c Case) timingPretty() string {
+	latency := c.Result.Latency.Round(time.Millisecond)
+	if c.Config.AwaitSlot > 0 {
+		return fmt.Sprintf("(latency %s, waited %s for slot %d)", latency, c.Result.SlotWait.Round(time.Millisecond), c.Config.AwaitSlot)
+	}
+	return fmt.Sprintf("(latency %s)", latency)
+}
+
+// Name identifies the Case by its method and route, with the query params
+// appended to the end, e.g. "GET /eth/v1/beacon/headers?slot=1".
+func (c Case) Name() string {
+	// Scenarios are identified by their name, or their file if they have
+	// none.
+	if len(c.Config.Steps) > 0 {
+		name := c.Config.Name
+		if name == "" {
+			name = c.Config.Source
+		}
+		return fmt.Sprintf("SCENARIO %s", name)
+	}
+
 	routeString := fmt.Sprintf("%s %s", c.Config.Method, c.Config.Route)
 	if len(c.Config.QueryParams) > 0 {
+		keys := make([]string, 0, len(c.Config.QueryParams))
+		for key := range c.Config.QueryParams {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
 		routeString = fmt.Sprintf("%s%s", routeString, "?")
-		for i, x := range c.Config.QueryParams {
-			routeString = fmt.Sprintf("%s%s=%s&", routeString, i, x)
+		for _, key := range keys {
+			routeString = fmt.Sprintf("%s%s=%s&", routeString, key, c.Config.QueryParams[key])
 		}
 		// Remove the trailing ampersand
 		routeString = routeString[:len(routeString)-1]
 	}
 
-	var resultString string
-	if c.Skipped {
-		resultString = fmt.Sprintf("%s skipped\n", routeString)
-	} else if !c.Result.Success {
-		resultString = fmt.Sprintf("%s ❌\n%s", routeString, c.Result.Error.Error())
-	} else {
-		resultString = fmt.Sprintf("%s ✅\n", routeString)
+	return routeString
+}
+
+// responseBodyError is satisfied by errors which carry the body of an error
+// response from the target, like eth2spec.GenericOpenAPIError.
+type responseBodyError interface {
+	error
+	Body() []byte
+}
+
+// expectsErrorStatus reports whether an executor result for an error response
+// has the status code the Case expects, for example a 400 for a deliberately
+// invalid request body. Such responses are evaluated by assertExpectations
+// instead of failing the Case outright. Errors for other statuses, like a 200
+// whose body cannot be decoded, always fail the Case.
+func (c Case) expectsErrorStatus(result *oapi.ExecutorResult) bool {
+	if result == nil || result.StatusCode == nil || !isErrorStatus(*result.StatusCode) {
+		return false
 	}
 
-	return resultString
+	return c.Config.ExpectedRespStatus != 0 && c.Config.ExpectedRespStatus == *result.StatusCode
+}
+
+// isErrorStatus reports whether the generated client treats a status code as
+// an error, which it does for any status of 300 or above.
+func isErrorStatus(status int) bool {
+	return status >= 300
+}
+
+// validate checks that the CaseConfig is well-formed, so that ill-formed test
+// cases are reported before any test is executed.
+func (c CaseConfig) validate() error {
+	for _, duration := range []string{c.Timeout, c.MaxLatency} {
+		if duration == "" {
+			continue
+		}
+		if _, err := time.ParseDuration(duration); err != nil {
+			return err
+		}
+	}
+
+	switch c.BodyMatch {
+	case "", BodyMatchExact, BodyMatchSubset:
+	default:
+		return fmt.Errorf("bodyMatch must be %q or %q, not %q", BodyMatchExact, BodyMatchSubset, c.BodyMatch)
+	}
+
+	for _, path := range c.IgnorePaths {
+		if _, err := parseJSONPath(path); err != nil {
+			return err
+		}
+	}
+
+	for _, assertion := range c.Assertions {
+		if err := assertion.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, expectation := range c.ExpectedRespHeaders {
+		if err := expectation.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Await != nil {
+		if err := c.Await.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Events != nil {
+		if err := c.Events.validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(c.Steps) > 0 && (c.Method != "" || c.Route != "") {
+		return fmt.Errorf("A scenario cannot have a method or route; set them on its steps instead")
+	}
+	for _, step := range c.Steps {
+		if err := step.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // setFailure marks a test case as having failed and records a corresponding
@@ -147,56 +520,181 @@ func (c *Case) setFailure(err error) {
 	c.Result.Error = err
 }
 
+// setTimeout marks a test case as having run out of time, and records a
+// corresponding error.
+func (c *Case) setTimeout(err error) {
+	c.setFailure(err)
+	c.Result.TimedOut = true
+}
+
+// isUnmetWait reports whether err is from a wait which ran out of time.
+func isUnmetWait(err error) bool {
+	switch err.(type) {
+	case *target.ClientMissingTargetSlotErr, *target.TargetLaggingErr, target.UnmetConditionError:
+		return true
+	}
+	return false
+}
+
+// WithTimeout is like context.WithTimeout, but returns a cancelable copy of ctx
+// without a new deadline if timeout is zero.
+func WithTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 // assertExpectations does expectations checking for the Case and returns an
 // error if any stated expectations are not satisfied by actual results.
 func (c Case) assertExpectations(result *oapi.ExecutorResult) error {
 	// If the config has an expected resonse status, evaluate that.
 	if c.Config.ExpectedRespStatus != 0 {
 		if c.Config.ExpectedRespStatus != *result.StatusCode {
-			return fmt.Errorf("Expected status code: %d\nReceived status code: %d", c.Config.ExpectedRespStatus, *result.StatusCode)
+			return StatusMismatchError{Expected: c.Config.ExpectedRespStatus, Received: *result.StatusCode}
+		}
+	}
+
+	// If the config has expected response headers, evaluate those.
+	if len(c.Config.ExpectedRespHeaders) > 0 {
+		if err := c.assertHeaders(result.Header); err != nil {
+			return err
 		}
 	}
 
 	// If the config has an expected response body, evaluate that.
 	if c.Config.ExpectedRespBody != nil {
-		// Get serialized JSON bytes for the expected response body, since Go
-		// only has an `interface{}` type for the user-specified response body.
-		data, err := json.Marshal(c.Config.ExpectedRespBody)
+		// The expected body as written, for comparing to the body as
+		// received, and for reporting mismatches.
+		written, err := normalizeJSON(c.Config.ExpectedRespBody)
 		if err != nil {
 			return err
 		}
 
-		// Unmarshal the JSON bytes into the appropriate Go type, provided by
-		// the result.ResponseDS.
-		err = json.Unmarshal(data, &result.ResponseDS)
-		if err != nil {
+		var expected, actual interface{}
+		if c.Config.BodyMatch == BodyMatchSubset || len(c.Config.IgnorePaths) > 0 {
+			// Subset matching and ignored paths select values by their
+			// keys, so the expected body is compared as written to the body
+			// as received. Decoding it into the response type would drop
+			// keys the type does not have, like misspelled ones, and zero
+			// values.
+			expected = written
+			actual, err = actualBody(result)
+			if err != nil {
+				return err
+			}
+		} else {
+			// Canonicalize the expected response body by decoding it into
+			// the appropriate Go type, provided by the result.ResponseDS.
+			// Users may specify object keys in any order, and fields the
+			// type does not have, but the Go type only keeps what the actual
+			// response could hold.
+			expected, err = canonicalizeExpected(c.Config.ExpectedRespBody, result.ResponseDS)
+			if err != nil {
+				return err
+			}
+
+			// Canonicalize the actual received response. Because the
+			// result.Response is already the same Go type as the
+			// result.ResponseDS, this produces a canonical form identical to
+			// the canonical form of an equal expected response.
+			actual, err = canonicalize(result)
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := ignorePaths(expected, c.Config.IgnorePaths); err != nil {
+			return err
+		}
+		if err := ignorePaths(actual, c.Config.IgnorePaths); err != nil {
 			return err
 		}
 
-		// Re-serialize the expected response back out into JSON bytes. This
-		// time, the JSON bytes will be in a canonicalized form for their type.
-		// Users may specify object keys in any order, but marshaling JSON from
-		// a Go type will give you a canonical JSON encoding for that type.
-		canonicalizedExpected, err := json.Marshal(result.ResponseDS)
+		// Serializing the canonical forms sorts object keys, so we can just
+		// do a bytes comparison to check equality. Subset matching instead
+		// walks the canonical forms. Mismatches are reported with the
+		// expected body as written.
+		canonicalizedExpected, err := json.Marshal(expected)
 		if err != nil {
 			return err
 		}
-
-		// Serialize the actual received response into JSON bytes. Because the
-		// result.Response is already a specific Go type (the same type as the
-		// result.ResponseDS), serializing should produce a canonical form
-		// identical to the canonical form of the expected response.
-		canonicalizedActual, err := json.Marshal(result.Response)
+		canonicalizedActual, err := json.Marshal(actual)
 		if err != nil {
 			return err
 		}
+		writtenExpected, err := json.Marshal(written)
+		if err != nil {
+			return err
+		}
+		if c.Config.BodyMatch == BodyMatchSubset {
+			if differences := diffSubset("", expected, actual, nil); len(differences) > 0 {
+				return BodyMismatchError{Expected: canonicalizedExpected, Received: canonicalizedActual, Differences: differences}
+			}
+		} else if !bytes.Equal(canonicalizedExpected, canonicalizedActual) {
+			return BodyMismatchError{Expected: writtenExpected, Received: canonicalizedActual}
+		}
+	}
 
-		// Because the serialized JSON bytes are canonicalized, we can just do
-		// a bytes comparison to check equality.
-		if !bytes.Equal(canonicalizedExpected, canonicalizedActual) {
-			return fmt.Errorf("Expected response body:\n%s\n\nReceived response body:\n%s", canonicalizedExpected, canonicalizedActual)
+	// If the config has assertions, evaluate those.
+	if len(c.Config.Assertions) > 0 {
+		body, err := actualBody(result)
+		if err != nil {
+			return err
+		}
+		for _, assertion := range c.Config.Assertions {
+			if err := assertion.check(body); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
+
+// canonicalize returns the Response of an executor result in canonical form,
+// decoded into generic JSON types.
+func canonicalize(result *oapi.ExecutorResult) (interface{}, error) {
+	if result == nil || result.Response == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(result.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	var canonical interface{}
+	if err := json.Unmarshal(data, &canonical); err != nil {
+		return nil, err
+	}
+	return canonical, nil
+}
+
+// canonicalizeExpected returns an expected response body in canonical form,
+// decoded into generic JSON types. If ds is not nil, the body is first decoded
+// into a new value of the same type as ds, so that it is canonicalized the
+// same way as a response decoded into that type.
+func canonicalizeExpected(expected interface{}, ds interface{}) (interface{}, error) {
+	data, err := json.Marshal(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	if ds != nil {
+		typed := reflect.New(reflect.TypeOf(ds))
+		if err := json.Unmarshal(data, typed.Interface()); err != nil {
+			return nil, err
+		}
+		data, err = json.Marshal(typed.Elem().Interface())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var canonical interface{}
+	if err := json.Unmarshal(data, &canonical); err != nil {
+		return nil, err
+	}
+	return canonical, nil
+}
@@ -0,0 +1,219 @@
+package testcases
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/INFURA/eth2-comply/pkg/target"
+)
+
+// variablePattern matches a reference to a variable, like "${root}".
+var variablePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+type UndefinedVariableError struct {
+	Name string
+}
+
+func (e UndefinedVariableError) Error() string {
+	return fmt.Sprintf("Variable ${%s} is not defined", e.Name)
+}
+
+type VariableError struct {
+	Name string
+	Err  error
+}
+
+func (e VariableError) Error() string {
+	return fmt.Sprintf("Cannot resolve ${%s}: %s", e.Name, e.Err.Error())
+}
+
+// variables looks up the value of a variable by name.
+type variables func(name string) (interface{}, error)
+
+// newVariables returns variables which are looked up among captured first, and
+// then among the built-in variables of the target in ctx.
+func newVariables(ctx context.Context, captured map[string]interface{}, resolver *target.VariableResolver) variables {
+	return func(name string) (interface{}, error) {
+		if value, ok := captured[name]; ok {
+			return value, nil
+		}
+		value, ok, err := resolver.Resolve(ctx, name)
+		if !ok {
+			return nil, UndefinedVariableError{Name: name}
+		}
+		if err != nil {
+			return nil, VariableError{Name: name, Err: err}
+		}
+		return value, nil
+	}
+}
+
+// Resolve returns a copy of the Case with references to built-in variables,
+// like "${head_slot}", replaced by their current values for the target in
+// ctx. See target.Variables for the built-in variables. The steps of a
+// scenario are not resolved until they are executed.
+func (c Case) Resolve(ctx context.Context) (*Case, error) {
+	config, err := c.Config.resolve(newVariables(ctx, nil, target.NewVariableResolver()))
+	if err != nil {
+		return nil, err
+	}
+	c.Config = config
+	return &c, nil
+}
+
+// variableNames returns the sorted references, like "${head_slot}", to the
+// variables the CaseConfig uses, without resolving them.
+func (c CaseConfig) variableNames() []string {
+	seen := map[string]bool{}
+	c.resolve(func(name string) (interface{}, error) {
+		seen[name] = true
+		return "", nil
+	})
+
+	names := []string{}
+	for name := range seen {
+		names = append(names, "${"+name+"}")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// isVariable reports whether a value is a string consisting of nothing but a
+// reference to a variable.
+func isVariable(v interface{}) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+	loc := variablePattern.FindStringIndex(s)
+	return loc != nil && loc[0] == 0 && loc[1] == len(s)
+}
+
+// interpolate replaces every reference to a variable in s with the variable's
+// value. Strings are inserted as they are, and other values as JSON.
+func interpolate(s string, vars variables) (string, error) {
+	var err error
+	interpolated := variablePattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := variablePattern.FindStringSubmatch(ref)[1]
+		value, lookupErr := vars(name)
+		if lookupErr != nil {
+			err = lookupErr
+			return ref
+		}
+		switch value := value.(type) {
+		case string:
+			return value
+		case float64:
+			return strconv.FormatFloat(value, 'f', -1, 64)
+		}
+		data, _ := json.Marshal(value)
+		return string(data)
+	})
+	return interpolated, err
+}
+
+// substitute returns a copy of a value decoded into generic JSON types, with
+// references to variables replaced. A string consisting of nothing but a
+// reference is replaced by the variable's value, whatever its type, so that
+// numbers, objects and arrays can be used as well as strings.
+func substitute(v interface{}, vars variables) (interface{}, error) {
+	switch v := v.(type) {
+	case string:
+		if isVariable(v) {
+			name := variablePattern.FindStringSubmatch(v)[1]
+			return vars(name)
+		}
+		return interpolate(v, vars)
+
+	case map[string]interface{}:
+		substituted := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			value, err := substitute(value, vars)
+			if err != nil {
+				return nil, err
+			}
+			substituted[key] = value
+		}
+		return substituted, nil
+
+	case []interface{}:
+		substituted := make([]interface{}, len(v))
+		for i, value := range v {
+			value, err := substitute(value, vars)
+			if err != nil {
+				return nil, err
+			}
+			substituted[i] = value
+		}
+		return substituted, nil
+	}
+
+	return v, nil
+}
+
+// resolve returns a copy of the CaseConfig with references to variables
+// replaced in its route, query params, request body and expectations.
+func (c CaseConfig) resolve(vars variables) (CaseConfig, error) {
+	var err error
+
+	if c.Route, err = interpolate(c.Route, vars); err != nil {
+		return CaseConfig{}, err
+	}
+
+	if c.QueryParams != nil {
+		queryParams := make(map[string]string, len(c.QueryParams))
+		for key, value := range c.QueryParams {
+			if queryParams[key], err = interpolate(value, vars); err != nil {
+				return CaseConfig{}, err
+			}
+		}
+		c.QueryParams = queryParams
+	}
+
+	if c.ReqBody, err = substitute(c.ReqBody, vars); err != nil {
+		return CaseConfig{}, err
+	}
+	if c.ExpectedRespBody, err = substitute(c.ExpectedRespBody, vars); err != nil {
+		return CaseConfig{}, err
+	}
+
+	if c.ExpectedRespHeaders != nil {
+		headers := make(map[string]HeaderExpectation, len(c.ExpectedRespHeaders))
+		for name, expectation := range c.ExpectedRespHeaders {
+			if expectation.Equals, err = interpolate(expectation.Equals, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			if expectation.Regex, err = interpolate(expectation.Regex, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			headers[name] = expectation
+		}
+		c.ExpectedRespHeaders = headers
+	}
+
+	if c.Assertions != nil {
+		assertions := make([]Assertion, len(c.Assertions))
+		for i, assertion := range c.Assertions {
+			if assertion.Regex, err = interpolate(assertion.Regex, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			if assertion.Equals, err = substitute(assertion.Equals, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			if assertion.Min, err = substitute(assertion.Min, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			if assertion.Max, err = substitute(assertion.Max, vars); err != nil {
+				return CaseConfig{}, err
+			}
+			assertions[i] = assertion
+		}
+		c.Assertions = assertions
+	}
+
+	return c, nil
+}